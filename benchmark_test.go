@@ -360,3 +360,218 @@ func BenchmarkToStringSlice(b *testing.B) {
 		})
 	}
 }
+
+// Benchmark for Bitset deduplication against ToSet on dense ID ranges
+func BenchmarkBitsetDedup(b *testing.B) {
+	sizes := []int{1000, 100000, 1000000}
+
+	for _, size := range sizes {
+		// Dense IDs in [0, size/2) with every value appearing twice
+		slice := make([]int, size)
+		for i := 0; i < size; i++ {
+			slice[i] = (i * 7919) % (size / 2)
+		}
+
+		b.Run(fmt.Sprintf("ToSet-size-%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ToSet(slice)
+			}
+		})
+
+		b.Run(fmt.Sprintf("Bitset-size-%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				BitsetToSlice[int](ToBitset(slice))
+			}
+		})
+	}
+}
+
+// Benchmark for Bitset membership test against Contains on dense ID ranges
+func BenchmarkBitsetTest(b *testing.B) {
+	sizes := []int{100, 10000}
+
+	for _, size := range sizes {
+		slice := make([]int, size)
+		for i := 0; i < size; i++ {
+			slice[i] = i
+		}
+		bitset := ToBitset(slice)
+		target := size / 2
+
+		b.Run(fmt.Sprintf("Contains-size-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Contains(slice, target)
+			}
+		})
+
+		b.Run(fmt.Sprintf("Bitset-size-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bitset.Test(uint(target))
+			}
+		})
+	}
+}
+
+// Benchmark for compensated summation against a naive loop
+func BenchmarkSum(b *testing.B) {
+	sizes := []int{100, 10000}
+
+	for _, size := range sizes {
+		values := make([]float64, size)
+		for i := 0; i < size; i++ {
+			values[i] = float64(i) * 0.1
+		}
+
+		b.Run(fmt.Sprintf("naive-size-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				total := 0.0
+				for _, v := range values {
+					total += v
+				}
+				_ = total
+			}
+		})
+
+		b.Run(fmt.Sprintf("Sum-size-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Sum(values)
+			}
+		})
+	}
+}
+
+// Benchmark for Quantile, dominated by sorting a copy of the input
+func BenchmarkQuantile(b *testing.B) {
+	sizes := []int{100, 10000}
+
+	for _, size := range sizes {
+		values := make([]int, size)
+		for i := 0; i < size; i++ {
+			values[i] = (i * 7919) % size
+		}
+
+		b.Run(fmt.Sprintf("size-%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = Quantile(values, 0.95, QuantileLinear)
+			}
+		})
+	}
+}
+
+// Benchmark for TDigest.Add, including the periodic compression of the buffer
+func BenchmarkTDigestAdd(b *testing.B) {
+	d := NewTDigest(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.Add(float64((i * 7919) % 100003))
+	}
+}
+
+// Benchmark for DedupInPlace, against the allocating ToSet
+func BenchmarkDedupInPlace(b *testing.B) {
+	sizes := []int{100, 10000}
+
+	for _, size := range sizes {
+		slice := make([]int, size)
+		for i := 0; i < size; i++ {
+			slice[i] = i % (size / 2) // Creates duplicates
+		}
+		work := make([]int, size)
+
+		b.Run(fmt.Sprintf("ToSet-size-%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ToSet(slice)
+			}
+		})
+
+		b.Run(fmt.Sprintf("DedupInPlace-size-%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				copy(work, slice)
+				DedupInPlace(work)
+			}
+		})
+	}
+}
+
+// Benchmark for FilterInPlace and CompactInPlace, which do not allocate
+func BenchmarkFilterInPlace(b *testing.B) {
+	sizes := []int{100, 10000}
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	for _, size := range sizes {
+		slice := make([]int, size)
+		for i := 0; i < size; i++ {
+			slice[i] = i / 3
+		}
+		work := make([]int, size)
+
+		b.Run(fmt.Sprintf("TryFilter-size-%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = TryFilter(slice, func(n int) (bool, error) { return isEven(n), nil })
+			}
+		})
+
+		b.Run(fmt.Sprintf("FilterInPlace-size-%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				copy(work, slice)
+				FilterInPlace(work, isEven)
+			}
+		})
+
+		b.Run(fmt.Sprintf("CompactInPlace-size-%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				copy(work, slice)
+				CompactInPlace(work)
+			}
+		})
+	}
+}
+
+// Benchmark for InsertAt into a slice with spare capacity, against ConcatSlices
+func BenchmarkInsertAt(b *testing.B) {
+	slice := make([]int, 1000)
+	values := []int{-1, -2, -3}
+	work := make([]int, len(slice), len(slice)+len(values))
+
+	b.Run("ConcatSlices", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ConcatSlices(slice[:500], values, slice[500:])
+		}
+	})
+
+	b.Run("InsertAt", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			copy(work[:len(slice)], slice)
+			InsertAt(work[:len(slice)], 500, values...)
+		}
+	})
+}
+
+// Benchmark for EachPermutation, which reuses its tuple, against Permutations
+func BenchmarkPermutations(b *testing.B) {
+	slice := []int{1, 2, 3, 4, 5, 6, 7}
+
+	b.Run("Permutations", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Permutations(slice)
+		}
+	})
+
+	b.Run("EachPermutation", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			EachPermutation(slice, func([]int) bool { return true })
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package gofunc
+
+import "math/bits"
+
+const bitsetWordSize = 64
+
+// Bitset is a dense set of non-negative integers backed by a slice of 64-bit words.
+// It is considerably faster and smaller than a map-based set when the stored values
+// fall into a compact range, such as sequential IDs.
+// The zero value is an empty set ready to use; the set grows automatically on Set.
+//
+// Example:
+//
+//	var b gofunc.Bitset
+//	b.Set(1).Set(3).Set(5)
+//	// b.Test(3) is true, b.Count() is 3
+type Bitset struct {
+	words []uint64
+}
+
+// NewBitset creates an empty Bitset with room for values in [0, capacity)
+// without further allocation.
+//
+// Example:
+//
+//	b := gofunc.NewBitset(1024)
+//	// b.Len() is 1024, b.Count() is 0
+func NewBitset(capacity uint) *Bitset {
+	return &Bitset{words: make([]uint64, wordsNeeded(capacity))}
+}
+
+// ToBitset creates a Bitset containing every value of the slice.
+// Panics with an *ArgumentError if the slice contains a negative value, since it cannot
+// be stored in a Bitset; use TryToBitset to get an error instead.
+//
+// Example:
+//
+//	ids := []int{5, 1, 5, 3}
+//	b := gofunc.ToBitset(ids)
+//	// b contains 1, 3 and 5
+func ToBitset[T Int | UInt](s []T) *Bitset {
+	return Must(TryToBitset(s))
+}
+
+// TryToBitset creates a Bitset containing every value of the slice, like ToBitset.
+// Returns an *ArgumentError if the slice contains a negative value, or the largest value of
+// uint or a larger one, which cannot be stored in a Bitset.
+func TryToBitset[T Int | UInt](s []T) (*Bitset, error) {
+	var max T
+	for i := range s {
+		if s[i] < 0 {
+			return nil, newArgumentError("s", "negative values cannot be stored in a Bitset")
+		}
+		if s[i] > max {
+			max = s[i]
+		}
+	}
+
+	if uint64(max) >= uint64(^uint(0)) {
+		return nil, newArgumentError("s", "values must be less than the largest uint")
+	}
+
+	b := &Bitset{}
+	if len(s) == 0 {
+		return b, nil
+	}
+	// unlike wordsNeeded(max+1), this cannot wrap around for values close to the limit
+	b.words = make([]uint64, uint(max)/bitsetWordSize+1)
+	for i := range s {
+		v := uint(s[i])
+		b.words[v/bitsetWordSize] |= 1 << (v % bitsetWordSize)
+	}
+	return b, nil
+}
+
+// BitsetToSlice returns the values of the Bitset as a slice in ascending order.
+// Values that do not fit in the target type are truncated by the conversion,
+// the same way ToNumberSlice converts between numeric types.
+//
+// Example:
+//
+//	b := gofunc.ToBitset([]int{5, 1, 5, 3})
+//	ids := gofunc.BitsetToSlice[int](b)
+//	// ids is []int{1, 3, 5}
+func BitsetToSlice[T Int | UInt](b *Bitset) []T {
+	result := make([]T, 0, b.Count())
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		result = append(result, T(i))
+	}
+	return result
+}
+
+// Len returns the number of bits the Bitset can currently hold without growing.
+func (b *Bitset) Len() uint {
+	return uint(len(b.words)) * bitsetWordSize
+}
+
+// Set adds the value i to the set, growing the set if needed.
+// Returns the Bitset to allow chaining.
+func (b *Bitset) Set(i uint) *Bitset {
+	w := i / bitsetWordSize
+	if w >= uint(len(b.words)) {
+		b.grow(w + 1)
+	}
+	b.words[w] |= 1 << (i % bitsetWordSize)
+	return b
+}
+
+// Clear removes the value i from the set.
+// Returns the Bitset to allow chaining.
+func (b *Bitset) Clear(i uint) *Bitset {
+	w := i / bitsetWordSize
+	if w < uint(len(b.words)) {
+		b.words[w] &^= 1 << (i % bitsetWordSize)
+	}
+	return b
+}
+
+// Test reports whether the value i is in the set.
+func (b *Bitset) Test(i uint) bool {
+	w := i / bitsetWordSize
+	if w >= uint(len(b.words)) {
+		return false
+	}
+	return b.words[w]&(1<<(i%bitsetWordSize)) != 0
+}
+
+// Count returns the number of values in the set.
+func (b *Bitset) Count() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// NextSet returns the smallest value in the set that is greater than or equal to i.
+// Returns false if there is no such value.
+//
+// Example:
+//
+//	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+//		// use i
+//	}
+func (b *Bitset) NextSet(i uint) (uint, bool) {
+	w := i / bitsetWordSize
+	if w >= uint(len(b.words)) {
+		return 0, false
+	}
+
+	// mask off the bits below i in the first word
+	word := b.words[w] >> (i % bitsetWordSize)
+	if word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < uint(len(b.words)); w++ {
+		if b.words[w] != 0 {
+			return w*bitsetWordSize + uint(bits.TrailingZeros64(b.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+// Union returns a new Bitset containing the values present in either set.
+// Neither input is modified.
+func (b *Bitset) Union(other *Bitset) *Bitset {
+	long, short := b.words, other.words
+	if len(long) < len(short) {
+		long, short = short, long
+	}
+	result := &Bitset{words: make([]uint64, len(long))}
+	copy(result.words, long)
+	for i := range short {
+		result.words[i] |= short[i]
+	}
+	return result
+}
+
+// Intersect returns a new Bitset containing the values present in both sets.
+// Neither input is modified.
+func (b *Bitset) Intersect(other *Bitset) *Bitset {
+	n := len(b.words)
+	if len(other.words) < n {
+		n = len(other.words)
+	}
+	result := &Bitset{words: make([]uint64, n)}
+	for i := 0; i < n; i++ {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+	return result
+}
+
+// Difference returns a new Bitset containing the values of b that are not in other.
+// Neither input is modified.
+func (b *Bitset) Difference(other *Bitset) *Bitset {
+	result := &Bitset{words: make([]uint64, len(b.words))}
+	copy(result.words, b.words)
+	for i := 0; i < len(result.words) && i < len(other.words); i++ {
+		result.words[i] &^= other.words[i]
+	}
+	return result
+}
+
+// Equal reports whether both sets contain exactly the same values,
+// regardless of their capacity.
+func (b *Bitset) Equal(other *Bitset) bool {
+	long, short := b.words, other.words
+	if len(long) < len(short) {
+		long, short = short, long
+	}
+	for i := range short {
+		if long[i] != short[i] {
+			return false
+		}
+	}
+	for i := len(short); i < len(long); i++ {
+		if long[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Bitset) grow(words uint) {
+	// grow geometrically to amortize repeated Set calls on increasing values
+	if c := uint(cap(b.words)); words <= c {
+		b.words = b.words[:words]
+		return
+	}
+	newCap := 2 * uint(cap(b.words))
+	if newCap < words {
+		newCap = words
+	}
+	grown := make([]uint64, words, newCap)
+	copy(grown, b.words)
+	b.words = grown
+}
+
+func wordsNeeded(n uint) uint {
+	return (n + bitsetWordSize - 1) / bitsetWordSize
+}
@@ -0,0 +1,114 @@
+package gofunc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Bitset_SetClearTest(t *testing.T) {
+	var b Bitset
+	assert.False(t, b.Test(0))
+	assert.Equal(t, 0, b.Count())
+
+	b.Set(0).Set(63).Set(64).Set(1000)
+	assert.True(t, b.Test(0))
+	assert.True(t, b.Test(63))
+	assert.True(t, b.Test(64))
+	assert.True(t, b.Test(1000))
+	assert.False(t, b.Test(1))
+	assert.False(t, b.Test(5000))
+	assert.Equal(t, 4, b.Count())
+	assert.GreaterOrEqual(t, b.Len(), uint(1001))
+
+	b.Clear(63).Clear(5000)
+	assert.False(t, b.Test(63))
+	assert.Equal(t, 3, b.Count())
+
+	nb := NewBitset(100)
+	assert.Equal(t, uint(128), nb.Len())
+	assert.Equal(t, 0, nb.Count())
+}
+
+func Test_Bitset_NextSet(t *testing.T) {
+	b := ToBitset([]uint{3, 64, 130})
+
+	i, ok := b.NextSet(0)
+	assert.True(t, ok)
+	assert.Equal(t, uint(3), i)
+
+	i, ok = b.NextSet(4)
+	assert.True(t, ok)
+	assert.Equal(t, uint(64), i)
+
+	i, ok = b.NextSet(65)
+	assert.True(t, ok)
+	assert.Equal(t, uint(130), i)
+
+	_, ok = b.NextSet(131)
+	assert.False(t, ok)
+	_, ok = b.NextSet(10000)
+	assert.False(t, ok)
+
+	var empty Bitset
+	_, ok = empty.NextSet(0)
+	assert.False(t, ok)
+}
+
+func Test_Bitset_SetOperations(t *testing.T) {
+	a := ToBitset([]int{1, 2, 3, 200})
+	b := ToBitset([]int{2, 3, 4})
+
+	assert.Equal(t, []int{1, 2, 3, 4, 200}, BitsetToSlice[int](a.Union(b)))
+	assert.Equal(t, []int{1, 2, 3, 4, 200}, BitsetToSlice[int](b.Union(a)))
+	assert.Equal(t, []int{2, 3}, BitsetToSlice[int](a.Intersect(b)))
+	assert.Equal(t, []int{2, 3}, BitsetToSlice[int](b.Intersect(a)))
+	assert.Equal(t, []int{1, 200}, BitsetToSlice[int](a.Difference(b)))
+	assert.Equal(t, []int{4}, BitsetToSlice[int](b.Difference(a)))
+
+	// Inputs are not modified
+	assert.Equal(t, []int{1, 2, 3, 200}, BitsetToSlice[int](a))
+	assert.Equal(t, []int{2, 3, 4}, BitsetToSlice[int](b))
+}
+
+func Test_Bitset_Equal(t *testing.T) {
+	a := ToBitset([]int{1, 2})
+	b := NewBitset(1000).Set(2).Set(1)
+	assert.True(t, a.Equal(b))
+	assert.True(t, b.Equal(a))
+
+	b.Set(999)
+	assert.False(t, a.Equal(b))
+	assert.False(t, b.Equal(a))
+	assert.True(t, (&Bitset{}).Equal(NewBitset(64)))
+}
+
+func Test_Bitset_Conversions(t *testing.T) {
+	type UserID uint32
+	assert.Equal(t, []UserID{}, BitsetToSlice[UserID](ToBitset([]UserID{})))
+	assert.Equal(t, []UserID{1, 2, 7}, BitsetToSlice[UserID](ToBitset([]UserID{7, 2, 1, 2, 7})))
+	assert.Equal(t, []int8{0, 127}, BitsetToSlice[int8](ToBitset([]int8{127, 0})))
+
+	t.Run("panics on negative values", func(t *testing.T) {
+		assert.Panics(t, func() { ToBitset([]int{1, -1}) })
+	})
+	t.Run("panics on values that overflow the size", func(t *testing.T) {
+		assert.Panics(t, func() { ToBitset([]uint{1, math.MaxUint}) })
+		assert.Panics(t, func() { ToBitset([]uint64{math.MaxUint64}) })
+	})
+
+	b, e := TryToBitset([]int{1, -1})
+	assert.Nil(t, b)
+	assert.ErrorIs(t, e, ErrInvalidArgument)
+	b, e = TryToBitset([]int{1})
+	assert.NoError(t, e)
+	assert.Equal(t, 1, b.Count())
+
+	b, e = TryToBitset([]uint{1, math.MaxUint})
+	assert.Nil(t, b)
+	assert.ErrorIs(t, e, ErrInvalidArgument)
+	b, e = TryToBitset([]uint64{math.MaxUint64})
+	assert.Nil(t, b)
+	assert.ErrorIs(t, e, ErrInvalidArgument)
+}
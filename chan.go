@@ -0,0 +1,339 @@
+package gofunc
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// The channel combinators below each start a goroutine that owns the output channels and
+// closes them when the input is exhausted or the context is done. Cancelling the context
+// is always enough to stop a pipeline: no goroutine is left blocked on a send, even if
+// nobody reads the outputs anymore. Inputs are not drained after cancellation.
+
+// SliceToChan returns a channel emitting every element of the slice in order,
+// closed once all elements are sent or the context is done.
+//
+// Example:
+//
+//	ch := gofunc.SliceToChan(ctx, []int{1, 2, 3})
+//	// ch emits 1, 2 and 3, then is closed
+func SliceToChan[T any](ctx context.Context, s []T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for i := range s {
+			if !sendCtx(ctx, out, s[i]) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// ChanToSlice reads the channel until it is closed and returns the values received.
+// If the context is done first, it returns the values received so far and ctx.Err().
+//
+// Example:
+//
+//	values, err := gofunc.ChanToSlice(ctx, gofunc.SliceToChan(ctx, []int{1, 2, 3}))
+//	// values is []int{1, 2, 3}, err is nil
+func ChanToSlice[T any](ctx context.Context, in <-chan T) ([]T, error) {
+	result := []T{}
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return result, nil
+			}
+			result = append(result, v)
+		case <-ctx.Done():
+			return result, ctx.Err()
+		}
+	}
+}
+
+// OrDone returns a channel emitting the values of in until in is closed or the context is done.
+// It lets a plain range loop stop on cancellation.
+//
+// Example:
+//
+//	for v := range gofunc.OrDone(ctx, events) {
+//		handle(v)
+//	}
+func OrDone[T any](ctx context.Context, in <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recvCtx(ctx, in)
+			if !ok || !sendCtx(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// ChanMap returns a channel emitting the result of fn for every value of in, in order.
+//
+// Example:
+//
+//	lengths := gofunc.ChanMap(ctx, words, func(w string) int {
+//		return len(w)
+//	})
+func ChanMap[T any, R any](ctx context.Context, in <-chan T, fn func(T) R) <-chan R {
+	out := make(chan R)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recvCtx(ctx, in)
+			if !ok || !sendCtx(ctx, out, fn(v)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// ChanFilter returns a channel emitting the values of in for which pred returns true.
+//
+// Example:
+//
+//	evens := gofunc.ChanFilter(ctx, numbers, func(n int) bool {
+//		return n%2 == 0
+//	})
+func ChanFilter[T any](ctx context.Context, in <-chan T, pred func(T) bool) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recvCtx(ctx, in)
+			if !ok {
+				return
+			}
+			if pred(v) && !sendCtx(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FanOut distributes the values of in over n channels in round-robin order,
+// so that n workers can process them concurrently.
+// A value waits for its channel to be read, so a slow reader holds up the others.
+// Panics with an *ArgumentError if n is not positive.
+//
+// Example:
+//
+//	for _, jobs := range gofunc.FanOut(ctx, allJobs, 4) {
+//		go worker(jobs)
+//	}
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	next := 0
+	return fanOut(ctx, in, n, func(T) int {
+		i := next
+		next = (next + 1) % n
+		return i
+	})
+}
+
+// FanOutBy distributes the values of in over n channels, sending each value to the
+// channel at index shard(value) modulo n. Values with the same shard are therefore
+// handled by the same reader, in order, which keeps per-key processing sequential.
+// Panics with an *ArgumentError if n is not positive.
+//
+// Example:
+//
+//	shards := gofunc.FanOutBy(ctx, orders, 8, func(o Order) int {
+//		return int(o.CustomerID)
+//	})
+func FanOutBy[T any](ctx context.Context, in <-chan T, n int, shard func(T) int) []<-chan T {
+	return fanOut(ctx, in, n, func(v T) int {
+		i := shard(v) % n
+		if i < 0 {
+			i += n
+		}
+		return i
+	})
+}
+
+func fanOut[T any](ctx context.Context, in <-chan T, n int, index func(T) int) []<-chan T {
+	if n <= 0 {
+		panic(newArgumentError("n", "must be positive"))
+	}
+	outs := make([]chan T, n)
+	result := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+		result[i] = outs[i]
+	}
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+		for {
+			v, ok := recvCtx(ctx, in)
+			if !ok || !sendCtx(ctx, outs[index(v)], v) {
+				return
+			}
+		}
+	}()
+	return result
+}
+
+// FanIn returns a channel emitting the values of every input channel as they arrive,
+// closed once all inputs are closed or the context is done.
+// Values of a single input keep their order; values of different inputs interleave.
+//
+// Example:
+//
+//	all := gofunc.FanIn(ctx, resultsA, resultsB, resultsC)
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(in <-chan T) {
+			defer wg.Done()
+			for {
+				v, ok := recvCtx(ctx, in)
+				if !ok || !sendCtx(ctx, out, v) {
+					return
+				}
+			}
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Merge is another name for FanIn.
+func Merge[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	return FanIn(ctx, ins...)
+}
+
+// Tee returns two channels that both emit every value of in.
+// Each value is delivered to both outputs before the next one is read,
+// so the slower reader sets the pace.
+//
+// Example:
+//
+//	toStore, toAudit := gofunc.Tee(ctx, events)
+func Tee[T any](ctx context.Context, in <-chan T) (<-chan T, <-chan T) {
+	out1, out2 := make(chan T), make(chan T)
+	go func() {
+		defer close(out1)
+		defer close(out2)
+		for {
+			v, ok := recvCtx(ctx, in)
+			if !ok {
+				return
+			}
+			// a nil channel blocks forever, which disables the output already served
+			o1, o2 := out1, out2
+			for o1 != nil || o2 != nil {
+				select {
+				case o1 <- v:
+					o1 = nil
+				case o2 <- v:
+					o2 = nil
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out1, out2
+}
+
+// Batch groups the values of in into slices of size values, like ChunkSlice does for a slice.
+// If timeout is positive, an incomplete batch is also emitted once timeout has passed since
+// its first value, so that values do not wait indefinitely on a slow input.
+// The last batch may be smaller. Panics with an *ArgumentError if size is not positive.
+//
+// Example:
+//
+//	for rows := range gofunc.Batch(ctx, rowChan, 500, time.Second) {
+//		db.InsertRows(rows)
+//	}
+func Batch[T any](ctx context.Context, in <-chan T, size int, timeout time.Duration) <-chan []T {
+	if size <= 0 {
+		panic(newArgumentError("size", "must be positive"))
+	}
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		var (
+			batch    []T
+			timer    *time.Timer
+			timeoutC <-chan time.Time // nil while the batch is empty or without timeout
+		)
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeoutC = nil, nil
+			}
+			b := batch
+			batch = nil
+			return sendCtx(ctx, out, b)
+		}
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 && timeout > 0 {
+					timer = time.NewTimer(timeout)
+					timeoutC = timer.C
+				}
+				if len(batch) == size && !flush() {
+					return
+				}
+			case <-timeoutC:
+				timer, timeoutC = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// sendCtx sends v on out, returning false if the context is done first.
+func sendCtx[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// recvCtx receives from in, returning false if in is closed or the context is done first.
+func recvCtx[T any](ctx context.Context, in <-chan T) (T, bool) {
+	select {
+	case v, ok := <-in:
+		return v, ok
+	case <-ctx.Done():
+		var zeroT T
+		return zeroT, false
+	}
+}
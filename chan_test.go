@@ -0,0 +1,244 @@
+package gofunc
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertNoLeak runs fn and checks that every goroutine it started has exited afterwards.
+func assertNoLeak(t *testing.T, fn func()) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	fn()
+	// poll by hand: assert.Eventually runs its condition on a goroutine of its own
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before; {
+		if time.Now().After(deadline) {
+			t.Errorf("%d goroutine(s) leaked", runtime.NumGoroutine()-before)
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func Test_Chan_SliceToChanAndBack(t *testing.T) {
+	ctx := context.Background()
+	assertNoLeak(t, func() {
+		values, err := ChanToSlice(ctx, SliceToChan(ctx, []int{1, 2, 3}))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, values)
+
+		values, err = ChanToSlice(ctx, SliceToChan(ctx, []int{}))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, values)
+	})
+
+	t.Run("stops on cancellation", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			ch := SliceToChan(ctx, []int{1, 2, 3})
+			assert.Equal(t, 1, <-ch)
+			cancel()
+
+			values, err := ChanToSlice(ctx, make(chan int))
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Equal(t, []int{}, values)
+		})
+	})
+}
+
+func Test_Chan_OrDone(t *testing.T) {
+	assertNoLeak(t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := OrDone(ctx, in)
+		go func() { in <- 1 }()
+		assert.Equal(t, 1, <-out)
+
+		// nothing is ever sent on in, yet the range loop ends
+		cancel()
+		for range out {
+		}
+	})
+}
+
+func Test_Chan_MapFilter(t *testing.T) {
+	ctx := context.Background()
+	assertNoLeak(t, func() {
+		numbers := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6})
+		evens := ChanFilter(ctx, numbers, func(n int) bool { return n%2 == 0 })
+		squares := ChanMap(ctx, evens, func(n int) int64 { return int64(n * n) })
+		values, err := ChanToSlice(ctx, squares)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{4, 16, 36}, values)
+	})
+
+	t.Run("abandoned pipeline", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			numbers := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6})
+			doubled := ChanMap(ctx, ChanFilter(ctx, numbers, func(int) bool { return true }), func(n int) int { return n * 2 })
+			assert.Equal(t, 2, <-doubled)
+			cancel()
+		})
+	})
+}
+
+func Test_Chan_FanOutFanIn(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("round robin", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			outs := FanOut(ctx, SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6, 7}), 3)
+			assert.Len(t, outs, 3)
+
+			var mu sync.Mutex
+			got := make([][]int, 3)
+			var wg sync.WaitGroup
+			for i, out := range outs {
+				wg.Add(1)
+				go func(i int, out <-chan int) {
+					defer wg.Done()
+					for v := range out {
+						mu.Lock()
+						got[i] = append(got[i], v)
+						mu.Unlock()
+					}
+				}(i, out)
+			}
+			wg.Wait()
+			assert.Equal(t, [][]int{{1, 4, 7}, {2, 5}, {3, 6}}, got)
+		})
+	})
+
+	t.Run("keyed", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			in := SliceToChan(ctx, []int{-3, -2, -1, 0, 1, 2, 3, 4})
+			outs := FanOutBy(ctx, in, 2, func(n int) int { return n })
+			merged, err := ChanToSlice(ctx, Merge(ctx, ChanMap(ctx, outs[0], func(n int) [2]int { return [2]int{0, n} }),
+				ChanMap(ctx, outs[1], func(n int) [2]int { return [2]int{1, n} })))
+			assert.NoError(t, err)
+
+			shards := map[int][]int{}
+			for _, p := range merged {
+				shards[p[0]] = append(shards[p[0]], p[1])
+			}
+			assert.Equal(t, []int{-2, 0, 2, 4}, shards[0])
+			assert.Equal(t, []int{-3, -1, 1, 3}, shards[1])
+		})
+	})
+
+	t.Run("fan in", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			merged, err := ChanToSlice(ctx, FanIn(ctx,
+				SliceToChan(ctx, []int{1, 2}),
+				SliceToChan(ctx, []int{3}),
+				SliceToChan(ctx, []int{4, 5, 6}),
+			))
+			assert.NoError(t, err)
+			sort.Ints(merged)
+			assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, merged)
+
+			empty, err := ChanToSlice(ctx, FanIn[int](ctx))
+			assert.NoError(t, err)
+			assert.Empty(t, empty)
+		})
+	})
+
+	t.Run("abandoned readers", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			outs := FanOut(ctx, SliceToChan(ctx, []int{1, 2, 3}), 2)
+			merged := FanIn(ctx, make(chan int), outs[1])
+			assert.Equal(t, 1, <-outs[0])
+			cancel()
+			for range merged {
+			}
+		})
+	})
+
+	t.Run("panics on invalid n", func(t *testing.T) {
+		assert.Panics(t, func() { FanOut(ctx, make(chan int), 0) })
+		assert.Panics(t, func() { FanOutBy(ctx, make(chan int), -1, func(n int) int { return n }) })
+	})
+}
+
+func Test_Chan_Tee(t *testing.T) {
+	ctx := context.Background()
+	assertNoLeak(t, func() {
+		a, b := Tee(ctx, SliceToChan(ctx, []string{"x", "y", "z"}))
+		var gotB []string
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			gotB, _ = ChanToSlice(ctx, b)
+		}()
+		gotA, err := ChanToSlice(ctx, a)
+		<-done
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"x", "y", "z"}, gotA)
+		assert.Equal(t, []string{"x", "y", "z"}, gotB)
+	})
+
+	t.Run("one reader abandoned", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			a, _ := Tee(ctx, SliceToChan(ctx, []int{1, 2}))
+			cancel()
+			for range a {
+			}
+		})
+	})
+}
+
+func Test_Chan_Batch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("by size", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			batches, err := ChanToSlice(ctx, Batch(ctx, SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6, 7}), 3, 0))
+			assert.NoError(t, err)
+			assert.Equal(t, ChunkSlice([]int{1, 2, 3, 4, 5, 6, 7}, 3), batches)
+
+			batches, err = ChanToSlice(ctx, Batch(ctx, SliceToChan(ctx, []int{}), 3, time.Second))
+			assert.NoError(t, err)
+			assert.Empty(t, batches)
+		})
+	})
+
+	t.Run("by timeout", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			in := make(chan int)
+			out := Batch(ctx, in, 10, 10*time.Millisecond)
+			in <- 1
+			in <- 2
+			assert.Equal(t, []int{1, 2}, <-out)
+			in <- 3
+			close(in)
+			assert.Equal(t, []int{3}, <-out)
+			_, ok := <-out
+			assert.False(t, ok)
+		})
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		assertNoLeak(t, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			in := make(chan int)
+			out := Batch(ctx, in, 10, time.Hour)
+			in <- 1
+			cancel()
+			for range out {
+			}
+		})
+	})
+
+	t.Run("panics on invalid size", func(t *testing.T) {
+		assert.Panics(t, func() { Batch(ctx, make(chan int), 0, 0) })
+	})
+}
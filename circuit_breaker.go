@@ -0,0 +1,347 @@
+package gofunc
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Executor runs a function under some protection policy, such as a CircuitBreaker or a Bulkhead.
+// Executors compose by nesting calls, and Execute adapts them to functions returning a value.
+type Executor interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Execute runs fn through the executor and returns its result.
+// If the executor rejects the call, fn is not run and the executor's error is returned.
+// The result can be passed to Must or ResultOf like any other (T, error) pair.
+//
+// Example:
+//
+//	user, err := gofunc.Execute(ctx, breaker, func(ctx context.Context) (User, error) {
+//		return client.GetUser(ctx, id)
+//	})
+func Execute[T any](ctx context.Context, ex Executor, fn func(ctx context.Context) (T, error)) (T, error) {
+	var v T
+	err := ex.Do(ctx, func(ctx context.Context) error {
+		var err error
+		v, err = fn(ctx)
+		return err
+	})
+	if err != nil {
+		var zeroT T
+		return zeroT, err
+	}
+	return v, nil
+}
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every call through while counting failures.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects every call until the open timeout elapses.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of trial calls through to probe for recovery.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitCounts holds the call statistics a TripPolicy decides on.
+// Counts are reset whenever the state changes and at every interval while closed.
+type CircuitCounts struct {
+	Requests             int
+	Successes            int
+	Failures             int
+	ConsecutiveSuccesses int
+	ConsecutiveFailures  int
+}
+
+// TripPolicy decides, after a failure, whether a closed CircuitBreaker should open.
+type TripPolicy func(counts CircuitCounts) bool
+
+// ConsecutiveFailuresTrip opens the circuit after n failures in a row.
+func ConsecutiveFailuresTrip(n int) TripPolicy {
+	return func(counts CircuitCounts) bool {
+		return counts.ConsecutiveFailures >= n
+	}
+}
+
+// FailureRatioTrip opens the circuit once at least minRequests calls were made and
+// the proportion of failures among them reaches ratio.
+func FailureRatioTrip(ratio float64, minRequests int) TripPolicy {
+	return func(counts CircuitCounts) bool {
+		return counts.Requests >= minRequests && float64(counts.Failures)/float64(counts.Requests) >= ratio
+	}
+}
+
+// CircuitBreakerOptions configures a CircuitBreaker.
+// The zero value opens after 5 consecutive failures and probes again after 60 seconds.
+type CircuitBreakerOptions struct {
+	// ShouldTrip decides whether to open the circuit after a failure.
+	// Defaults to ConsecutiveFailuresTrip(5).
+	ShouldTrip TripPolicy
+	// Interval is the period after which the counts of a closed circuit are reset.
+	// Zero means the counts are only reset on state changes.
+	Interval time.Duration
+	// OpenTimeout is how long the circuit stays open before turning half-open.
+	// Defaults to 60 seconds.
+	OpenTimeout time.Duration
+	// HalfOpenMaxCalls is the number of trial calls allowed while half-open.
+	// The circuit closes once that many calls succeed, and opens again on any failure.
+	// Defaults to 1.
+	HalfOpenMaxCalls int
+	// IsFailure reports whether an error counts as a failure.
+	// Defaults to counting every non-nil error.
+	IsFailure func(err error) bool
+	// OnStateChange is called with the previous and the new state on every transition.
+	// It is called while the breaker is locked, so it must not call the breaker back.
+	OnStateChange func(from, to CircuitState)
+	// Clock is used to measure timeouts and intervals. Defaults to SystemClock.
+	Clock Clock
+}
+
+// CircuitBreaker stops calling a failing dependency for a while, giving it time to recover
+// and failing fast in the meantime. It is closed while calls succeed, opens when its trip
+// policy says so, and turns half-open after a timeout to let trial calls decide whether to
+// close again. A CircuitBreaker is safe for concurrent use.
+//
+// Example:
+//
+//	breaker := gofunc.NewCircuitBreaker(gofunc.CircuitBreakerOptions{
+//		ShouldTrip:  gofunc.FailureRatioTrip(0.5, 20),
+//		OpenTimeout: 30 * time.Second,
+//	})
+//	user, err := gofunc.Execute(ctx, breaker, fetchUser)
+//	// err is ErrCircuitOpen while the dependency is considered down
+type CircuitBreaker struct {
+	opts CircuitBreakerOptions
+
+	mu         sync.Mutex
+	state      CircuitState
+	counts     CircuitCounts
+	generation uint64    // incremented on every reset, to ignore results of stale calls
+	expiry     time.Time // end of the current interval or open timeout
+}
+
+// NewCircuitBreaker creates a closed CircuitBreaker.
+func NewCircuitBreaker(opts CircuitBreakerOptions) *CircuitBreaker {
+	if opts.ShouldTrip == nil {
+		opts.ShouldTrip = ConsecutiveFailuresTrip(5)
+	}
+	if opts.OpenTimeout <= 0 {
+		opts.OpenTimeout = 60 * time.Second
+	}
+	if opts.HalfOpenMaxCalls <= 0 {
+		opts.HalfOpenMaxCalls = 1
+	}
+	if opts.IsFailure == nil {
+		opts.IsFailure = func(err error) bool { return err != nil }
+	}
+	if opts.Clock == nil {
+		opts.Clock = SystemClock()
+	}
+
+	cb := &CircuitBreaker{opts: opts}
+	cb.reset(opts.Clock.Now())
+	return cb
+}
+
+// State returns the current state of the CircuitBreaker.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.refresh(cb.opts.Clock.Now())
+	return cb.state
+}
+
+// Counts returns the call statistics of the current state.
+func (cb *CircuitBreaker) Counts() CircuitCounts {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.refresh(cb.opts.Clock.Now())
+	return cb.counts
+}
+
+// Do runs fn if the circuit allows it and records the outcome.
+// Returns ErrCircuitOpen without calling fn if the circuit is open, or if it is half-open
+// and all trial calls are already in flight. A panic in fn counts as a failure and is re-raised.
+func (cb *CircuitBreaker) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	generation, err := cb.before()
+	if err != nil {
+		return err
+	}
+
+	failed := true
+	defer func() {
+		cb.after(generation, failed)
+	}()
+	err = fn(ctx)
+	failed = cb.opts.IsFailure(err)
+	return err
+}
+
+func (cb *CircuitBreaker) before() (uint64, error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.refresh(cb.opts.Clock.Now())
+
+	switch {
+	case cb.state == CircuitOpen:
+		return 0, ErrCircuitOpen
+	case cb.state == CircuitHalfOpen && cb.counts.Requests >= cb.opts.HalfOpenMaxCalls:
+		return 0, ErrCircuitOpen
+	}
+	cb.counts.Requests++
+	return cb.generation, nil
+}
+
+func (cb *CircuitBreaker) after(generation uint64, failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	now := cb.opts.Clock.Now()
+	cb.refresh(now)
+	// the state changed while the call was running, so its outcome is irrelevant
+	if generation != cb.generation {
+		return
+	}
+
+	if failed {
+		cb.counts.Failures++
+		cb.counts.ConsecutiveFailures++
+		cb.counts.ConsecutiveSuccesses = 0
+		if cb.state == CircuitHalfOpen || cb.opts.ShouldTrip(cb.counts) {
+			cb.setState(CircuitOpen, now)
+		}
+		return
+	}
+
+	cb.counts.Successes++
+	cb.counts.ConsecutiveSuccesses++
+	cb.counts.ConsecutiveFailures = 0
+	if cb.state == CircuitHalfOpen && cb.counts.ConsecutiveSuccesses >= cb.opts.HalfOpenMaxCalls {
+		cb.setState(CircuitClosed, now)
+	}
+}
+
+// refresh applies the transitions driven by time: the end of an open timeout
+// or of a counting interval.
+func (cb *CircuitBreaker) refresh(now time.Time) {
+	if cb.expiry.IsZero() || now.Before(cb.expiry) {
+		return
+	}
+	switch cb.state {
+	case CircuitClosed:
+		cb.reset(now)
+	case CircuitOpen:
+		cb.setState(CircuitHalfOpen, now)
+	}
+}
+
+func (cb *CircuitBreaker) setState(state CircuitState, now time.Time) {
+	prev := cb.state
+	cb.state = state
+	cb.reset(now)
+	if cb.opts.OnStateChange != nil {
+		cb.opts.OnStateChange(prev, state)
+	}
+}
+
+func (cb *CircuitBreaker) reset(now time.Time) {
+	cb.generation++
+	cb.counts = CircuitCounts{}
+	switch {
+	case cb.state == CircuitOpen:
+		cb.expiry = now.Add(cb.opts.OpenTimeout)
+	case cb.state == CircuitClosed && cb.opts.Interval > 0:
+		cb.expiry = now.Add(cb.opts.Interval)
+	default:
+		cb.expiry = time.Time{}
+	}
+}
+
+// Bulkhead limits the number of concurrent executions of a function, so that a slow
+// dependency cannot exhaust every goroutine or connection of a service.
+// Calls beyond the limit wait in a bounded queue; calls beyond the queue are rejected.
+// A Bulkhead is safe for concurrent use.
+//
+// Example:
+//
+//	bulkhead := gofunc.NewBulkhead(10, 100)
+//	report, err := gofunc.Execute(ctx, bulkhead, generateReport)
+//	// err is ErrBulkheadFull when 10 reports run and 100 more are waiting
+type Bulkhead struct {
+	slots chan struct{}
+	queue chan struct{}
+}
+
+// NewBulkhead creates a Bulkhead running at most maxConcurrent calls at once,
+// with up to maxQueue further calls waiting for a free slot.
+// Panics with an *ArgumentError if maxConcurrent is not positive or maxQueue is negative.
+func NewBulkhead(maxConcurrent, maxQueue int) *Bulkhead {
+	if maxConcurrent <= 0 {
+		panic(newArgumentError("maxConcurrent", "must be positive"))
+	}
+	if maxQueue < 0 {
+		panic(newArgumentError("maxQueue", "must not be negative"))
+	}
+	return &Bulkhead{
+		slots: make(chan struct{}, maxConcurrent),
+		queue: make(chan struct{}, maxQueue),
+	}
+}
+
+// Active returns the number of calls currently running.
+func (b *Bulkhead) Active() int {
+	return len(b.slots)
+}
+
+// Queued returns the number of calls currently waiting for a slot.
+func (b *Bulkhead) Queued() int {
+	return len(b.queue)
+}
+
+// Do runs fn as soon as a slot is free.
+// Returns ErrBulkheadFull without calling fn if the queue is full, or the context error
+// if the context is done while waiting.
+func (b *Bulkhead) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	select {
+	case b.slots <- struct{}{}:
+	default:
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+	}
+	defer func() { <-b.slots }()
+
+	return fn(ctx)
+}
+
+func (b *Bulkhead) wait(ctx context.Context) error {
+	select {
+	case b.queue <- struct{}{}:
+	default:
+		return ErrBulkheadFull
+	}
+	defer func() { <-b.queue }()
+
+	select {
+	case b.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,252 @@
+package gofunc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CircuitBreaker_ConsecutiveFailures(t *testing.T) {
+	clock := newFakeClock()
+	errDown := errors.New("down")
+	transitions := []string{}
+	cb := NewCircuitBreaker(CircuitBreakerOptions{
+		ShouldTrip:  ConsecutiveFailuresTrip(3),
+		OpenTimeout: 10 * time.Second,
+		Clock:       clock,
+		OnStateChange: func(from, to CircuitState) {
+			transitions = append(transitions, from.String()+"->"+to.String())
+		},
+	})
+	fail := func(ctx context.Context) error { return errDown }
+	succeed := func(ctx context.Context) error { return nil }
+	ctx := context.Background()
+
+	// a success in between resets the consecutive failures
+	assert.ErrorIs(t, cb.Do(ctx, fail), errDown)
+	assert.ErrorIs(t, cb.Do(ctx, fail), errDown)
+	assert.NoError(t, cb.Do(ctx, succeed))
+	assert.ErrorIs(t, cb.Do(ctx, fail), errDown)
+	assert.ErrorIs(t, cb.Do(ctx, fail), errDown)
+	assert.Equal(t, CircuitClosed, cb.State())
+	assert.Equal(t, CircuitCounts{Requests: 5, Successes: 1, Failures: 4, ConsecutiveFailures: 2}, cb.Counts())
+
+	assert.ErrorIs(t, cb.Do(ctx, fail), errDown)
+	assert.Equal(t, CircuitOpen, cb.State())
+
+	calls := 0
+	err := cb.Do(ctx, func(ctx context.Context) error {
+		calls++
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 0, calls)
+
+	clock.Advance(10 * time.Second)
+	assert.Equal(t, CircuitHalfOpen, cb.State())
+	assert.NoError(t, cb.Do(ctx, succeed))
+	assert.Equal(t, CircuitClosed, cb.State())
+
+	assert.Equal(t, []string{"closed->open", "open->half-open", "half-open->closed"}, transitions)
+}
+
+func Test_CircuitBreaker_FailureRatio(t *testing.T) {
+	clock := newFakeClock()
+	cb := NewCircuitBreaker(CircuitBreakerOptions{
+		ShouldTrip: FailureRatioTrip(0.5, 4),
+		Interval:   time.Minute,
+		Clock:      clock,
+	})
+	ctx := context.Background()
+	outcome := func(err error) func(ctx context.Context) error {
+		return func(ctx context.Context) error { return err }
+	}
+	errDown := errors.New("down")
+
+	// not enough requests yet to judge the ratio
+	_ = cb.Do(ctx, outcome(errDown))
+	_ = cb.Do(ctx, outcome(errDown))
+	assert.Equal(t, CircuitClosed, cb.State())
+
+	// the interval resets the counts
+	clock.Advance(time.Minute)
+	assert.Equal(t, CircuitCounts{}, cb.Counts())
+
+	_ = cb.Do(ctx, outcome(nil))
+	_ = cb.Do(ctx, outcome(nil))
+	_ = cb.Do(ctx, outcome(errDown))
+	assert.Equal(t, CircuitClosed, cb.State())
+	_ = cb.Do(ctx, outcome(errDown))
+	assert.Equal(t, CircuitOpen, cb.State())
+}
+
+func Test_CircuitBreaker_HalfOpen(t *testing.T) {
+	clock := newFakeClock()
+	errDown := errors.New("down")
+	cb := NewCircuitBreaker(CircuitBreakerOptions{
+		ShouldTrip:       ConsecutiveFailuresTrip(1),
+		OpenTimeout:      time.Second,
+		HalfOpenMaxCalls: 2,
+		Clock:            clock,
+	})
+	ctx := context.Background()
+
+	_ = cb.Do(ctx, func(ctx context.Context) error { return errDown })
+	clock.Advance(time.Second)
+
+	t.Run("limits trial calls", func(t *testing.T) {
+		release := make(chan struct{})
+		started := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = cb.Do(ctx, func(ctx context.Context) error {
+					started <- struct{}{}
+					<-release
+					return nil
+				})
+			}()
+		}
+		<-started
+		<-started
+		assert.ErrorIs(t, cb.Do(ctx, func(ctx context.Context) error { return nil }), ErrCircuitOpen)
+		close(release)
+		wg.Wait()
+		assert.Equal(t, CircuitClosed, cb.State())
+	})
+
+	t.Run("reopens on failure", func(t *testing.T) {
+		_ = cb.Do(ctx, func(ctx context.Context) error { return errDown })
+		clock.Advance(time.Second)
+		assert.NoError(t, cb.Do(ctx, func(ctx context.Context) error { return nil }))
+		assert.Equal(t, CircuitHalfOpen, cb.State())
+		assert.ErrorIs(t, cb.Do(ctx, func(ctx context.Context) error { return errDown }), errDown)
+		assert.Equal(t, CircuitOpen, cb.State())
+	})
+}
+
+func Test_CircuitBreaker_IsFailureAndPanics(t *testing.T) {
+	errNotFound := errors.New("not found")
+	cb := NewCircuitBreaker(CircuitBreakerOptions{
+		ShouldTrip: ConsecutiveFailuresTrip(1),
+		IsFailure:  func(err error) bool { return err != nil && !errors.Is(err, errNotFound) },
+		Clock:      newFakeClock(),
+	})
+	ctx := context.Background()
+
+	assert.ErrorIs(t, cb.Do(ctx, func(ctx context.Context) error { return errNotFound }), errNotFound)
+	assert.Equal(t, CircuitClosed, cb.State())
+
+	assert.Panics(t, func() {
+		_ = cb.Do(ctx, func(ctx context.Context) error { panic("boom") })
+	})
+	assert.Equal(t, CircuitOpen, cb.State())
+}
+
+func Test_CircuitBreaker_Execute(t *testing.T) {
+	cb := NewCircuitBreaker(CircuitBreakerOptions{ShouldTrip: ConsecutiveFailuresTrip(1), Clock: newFakeClock()})
+	ctx := context.Background()
+
+	v, err := Execute(ctx, cb, func(ctx context.Context) (int, error) { return 42, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, 42, v)
+
+	v, err = Execute(ctx, cb, func(ctx context.Context) (int, error) { return 1, errors.New("down") })
+	assert.Error(t, err)
+	assert.Equal(t, 0, v)
+
+	_, err = Execute(ctx, cb, func(ctx context.Context) (int, error) { return 42, nil })
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Panics(t, func() {
+		Must(Execute(ctx, cb, func(ctx context.Context) (int, error) { return 42, nil }))
+	})
+}
+
+func Test_CircuitState_String(t *testing.T) {
+	assert.Equal(t, "closed", CircuitClosed.String())
+	assert.Equal(t, "open", CircuitOpen.String())
+	assert.Equal(t, "half-open", CircuitHalfOpen.String())
+	assert.Equal(t, "unknown", CircuitState(42).String())
+}
+
+func Test_Bulkhead(t *testing.T) {
+	t.Run("panics on invalid limits", func(t *testing.T) {
+		assert.Panics(t, func() { NewBulkhead(0, 1) })
+		assert.Panics(t, func() { NewBulkhead(1, -1) })
+	})
+
+	t.Run("limits concurrency and queue", func(t *testing.T) {
+		b := NewBulkhead(1, 1)
+		ctx := context.Background()
+		release := make(chan struct{})
+		running := make(chan struct{})
+		done := make(chan error, 2)
+
+		go func() {
+			done <- b.Do(ctx, func(ctx context.Context) error {
+				close(running)
+				<-release
+				return nil
+			})
+		}()
+		<-running
+
+		queuedRan := false
+		go func() {
+			done <- b.Do(ctx, func(ctx context.Context) error {
+				queuedRan = true
+				return nil
+			})
+		}()
+		assert.Eventually(t, func() bool { return b.Queued() == 1 }, time.Second, time.Millisecond)
+		assert.Equal(t, 1, b.Active())
+
+		err := b.Do(ctx, func(ctx context.Context) error { return nil })
+		assert.ErrorIs(t, err, ErrBulkheadFull)
+
+		close(release)
+		assert.NoError(t, <-done)
+		assert.NoError(t, <-done)
+		assert.True(t, queuedRan)
+		assert.Equal(t, 0, b.Active())
+		assert.Equal(t, 0, b.Queued())
+	})
+
+	t.Run("stops waiting when the context is done", func(t *testing.T) {
+		b := NewBulkhead(1, 1)
+		release := make(chan struct{})
+		running := make(chan struct{})
+		go func() {
+			_ = b.Do(context.Background(), func(ctx context.Context) error {
+				close(running)
+				<-release
+				return nil
+			})
+		}()
+		<-running
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := Execute(ctx, b, func(ctx context.Context) (string, error) { return "unreachable", nil })
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 0, b.Queued())
+		close(release)
+	})
+
+	t.Run("composes with a circuit breaker", func(t *testing.T) {
+		b := NewBulkhead(2, 0)
+		cb := NewCircuitBreaker(CircuitBreakerOptions{Clock: newFakeClock()})
+		ctx := context.Background()
+		v, err := Execute(ctx, cb, func(ctx context.Context) (string, error) {
+			return Execute(ctx, b, func(ctx context.Context) (string, error) { return "ok", nil })
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", v)
+	})
+}
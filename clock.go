@@ -0,0 +1,40 @@
+package gofunc
+
+import (
+	"context"
+	"time"
+)
+
+// Clock abstracts reading the current time and sleeping, so that time-dependent helpers
+// such as Retry can be tested deterministically with a fake implementation.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Sleep pauses for the given duration, returning ctx.Err() early if the context is done.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// SystemClock returns a Clock backed by the time package.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,229 @@
+package gofunc
+
+// The Each functions of this file generate their tuples one at a time, so that spaces too
+// large to hold in memory can be walked, and stop as soon as the callback returns false.
+// The slice passed to the callback is reused for the next tuple: copy it to keep it, like
+// the functions returning all the tuples at once do.
+
+// Product returns the cartesian product of the slices: every tuple made of one element of
+// each slice, in lexicographic order, the last position varying fastest. The product of no
+// slices is a single empty tuple, and the product with an empty slice has no tuples.
+// It holds the product of the lengths of the slices; use EachProduct for large products.
+//
+// Example:
+//
+//	pairs := gofunc.Product([]string{"a", "b"}, []string{"x", "y"})
+//	// pairs is [][]string{{"a", "x"}, {"a", "y"}, {"b", "x"}, {"b", "y"}}
+func Product[T any](slices ...[]T) [][]T {
+	result := make([][]T, 0)
+	EachProduct(slices, func(tuple []T) bool {
+		result = append(result, copyOf(tuple))
+		return true
+	})
+	return result
+}
+
+// EachProduct calls fn with each tuple of the cartesian product of the slices, in the
+// order of Product, until fn returns false. The tuple is reused between calls.
+//
+// Example:
+//
+//	gofunc.EachProduct([][]int{sizes, colors}, func(variant []int) bool {
+//		return check(variant) // stop at the first failing variant
+//	})
+func EachProduct[T any](slices [][]T, fn func(tuple []T) bool) {
+	for _, s := range slices {
+		if len(s) == 0 {
+			return
+		}
+	}
+
+	indexes := make([]int, len(slices))
+	tuple := make([]T, len(slices))
+	for i, s := range slices {
+		tuple[i] = s[0]
+	}
+	for {
+		if !fn(tuple) {
+			return
+		}
+		// advance the indexes like an odometer, from the last position
+		i := len(slices) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(slices[i]) {
+				tuple[i] = slices[i][indexes[i]]
+				break
+			}
+			indexes[i] = 0
+			tuple[i] = slices[i][0]
+		}
+		if i < 0 {
+			return
+		}
+	}
+}
+
+// Permutations returns all the orderings of the elements of a slice, in lexicographic order
+// of their positions in the slice. Equal elements are not merged, so a slice of n elements
+// has n! permutations, including the single empty permutation of an empty slice.
+// Use EachPermutation for more than a few elements.
+//
+// Example:
+//
+//	perms := gofunc.Permutations([]int{1, 2, 3})
+//	// perms is [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}
+func Permutations[T any](s []T) [][]T {
+	result := make([][]T, 0)
+	EachPermutation(s, func(perm []T) bool {
+		result = append(result, copyOf(perm))
+		return true
+	})
+	return result
+}
+
+// EachPermutation calls fn with each permutation of a slice, in the order of Permutations,
+// until fn returns false. The permutation is reused between calls.
+//
+// Example:
+//
+//	gofunc.EachPermutation(stops, func(route []Stop) bool {
+//		best = minRoute(best, route)
+//		return true
+//	})
+func EachPermutation[T any](s []T, fn func(perm []T) bool) {
+	indexes := make([]int, len(s))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	perm := copyOf(s)
+	for {
+		if !fn(perm) {
+			return
+		}
+		// find the last ascent, then swap its head with the smallest larger index after it
+		i := len(indexes) - 2
+		for i >= 0 && indexes[i] > indexes[i+1] {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		j := len(indexes) - 1
+		for indexes[j] < indexes[i] {
+			j--
+		}
+		indexes[i], indexes[j] = indexes[j], indexes[i]
+		ReverseInPlace(indexes[i+1:])
+		for k := i; k < len(indexes); k++ {
+			perm[k] = s[indexes[k]]
+		}
+	}
+}
+
+// Combinations returns all the ways to choose k elements of a slice, keeping their order in
+// the slice, in lexicographic order of their positions. There is a single empty combination
+// for k = 0, and none if k is negative or larger than the length of the slice.
+// Use EachCombination when there are many of them.
+//
+// Example:
+//
+//	pairs := gofunc.Combinations([]string{"a", "b", "c"}, 2)
+//	// pairs is [][]string{{"a", "b"}, {"a", "c"}, {"b", "c"}}
+func Combinations[T any](s []T, k int) [][]T {
+	result := make([][]T, 0)
+	EachCombination(s, k, func(comb []T) bool {
+		result = append(result, copyOf(comb))
+		return true
+	})
+	return result
+}
+
+// EachCombination calls fn with each combination of k elements of a slice, in the order of
+// Combinations, until fn returns false. The combination is reused between calls.
+//
+// Example:
+//
+//	gofunc.EachCombination(players, 2, func(match []Player) bool {
+//		schedule(match[0], match[1])
+//		return true
+//	})
+func EachCombination[T any](s []T, k int, fn func(comb []T) bool) {
+	eachCombination(s, k, fn)
+}
+
+// eachCombination is EachCombination, reporting whether fn stopped the iteration.
+func eachCombination[T any](s []T, k int, fn func(comb []T) bool) bool {
+	n := len(s)
+	if k < 0 || k > n {
+		return true
+	}
+
+	indexes := make([]int, k)
+	comb := make([]T, k)
+	for i := range indexes {
+		indexes[i] = i
+		comb[i] = s[i]
+	}
+	for {
+		if !fn(comb) {
+			return false
+		}
+		// find the last index that can still move right, then reset the following ones
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+		indexes[i]++
+		comb[i] = s[indexes[i]]
+		for j := i + 1; j < k; j++ {
+			indexes[j] = indexes[j-1] + 1
+			comb[j] = s[indexes[j]]
+		}
+	}
+}
+
+// PowerSet returns all the subsets of the elements of a slice, from the empty one to the
+// whole slice: the combinations of each size in increasing order, as returned by
+// Combinations. A slice of n elements has 2^n subsets; use EachSubset for more than a few.
+//
+// Example:
+//
+//	subsets := gofunc.PowerSet([]int{1, 2, 3})
+//	// subsets is [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+func PowerSet[T any](s []T) [][]T {
+	result := make([][]T, 0)
+	EachSubset(s, func(subset []T) bool {
+		result = append(result, copyOf(subset))
+		return true
+	})
+	return result
+}
+
+// EachSubset calls fn with each subset of the elements of a slice, in the order of PowerSet,
+// until fn returns false. The subset is reused between calls of the same size.
+//
+// Example:
+//
+//	gofunc.EachSubset(items, func(subset []Item) bool {
+//		if weight(subset) == target {
+//			found = append([]Item(nil), subset...)
+//			return false
+//		}
+//		return true
+//	})
+func EachSubset[T any](s []T, fn func(subset []T) bool) {
+	for k := 0; k <= len(s); k++ {
+		if !eachCombination(s, k, fn) {
+			return
+		}
+	}
+}
+
+// copyOf returns a copy of s that does not share its backing array.
+func copyOf[T any](s []T) []T {
+	return append(make([]T, 0, len(s)), s...)
+}
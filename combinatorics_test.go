@@ -0,0 +1,101 @@
+package gofunc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Combinatorics_Product(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}, Product([]int{1, 2}, []int{3, 4}))
+	assert.Equal(t, [][]int{{1, 2, 3}}, Product([]int{1}, []int{2}, []int{3}))
+	assert.Equal(t, [][]int{{1}, {2}}, Product([]int{1, 2}))
+	assert.Equal(t, [][]int{{}}, Product[int]())
+	assert.Equal(t, [][]int{}, Product([]int{1, 2}, []int{}))
+
+	assert.Len(t, Product([]int{1, 2, 3}, []int{1, 2}, []int{1, 2, 3, 4}), 24)
+}
+
+func Test_Combinatorics_Permutations(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}},
+		Permutations([]int{1, 2, 3}))
+	assert.Equal(t, [][]string{{"a"}}, Permutations([]string{"a"}))
+	assert.Equal(t, [][]int{{}}, Permutations([]int{}))
+
+	// equal elements are not merged, and the order follows their positions
+	assert.Equal(t, [][]int{{2, 1, 1}, {2, 1, 1}, {1, 2, 1}, {1, 1, 2}, {1, 2, 1}, {1, 1, 2}},
+		Permutations([]int{2, 1, 1}))
+
+	perms := Permutations([]int{1, 2, 3, 4, 5})
+	assert.Len(t, perms, 120)
+	assert.Len(t, ToSetPred(perms, func(p []int) [5]int { return *(*[5]int)(p) }), 120)
+}
+
+func Test_Combinatorics_Combinations(t *testing.T) {
+	s := []string{"a", "b", "c", "d"}
+	assert.Equal(t, [][]string{{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"}},
+		Combinations(s, 2))
+	assert.Equal(t, [][]string{{"a"}, {"b"}, {"c"}, {"d"}}, Combinations(s, 1))
+	assert.Equal(t, [][]string{s}, Combinations(s, 4))
+	assert.Equal(t, [][]string{{}}, Combinations(s, 0))
+	assert.Equal(t, [][]string{}, Combinations(s, 5))
+	assert.Equal(t, [][]string{}, Combinations(s, -1))
+	assert.Equal(t, [][]string{{}}, Combinations([]string{}, 0))
+
+	assert.Len(t, Combinations(make([]int, 10), 3), 120)
+}
+
+func Test_Combinatorics_PowerSet(t *testing.T) {
+	assert.Equal(t, [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}, PowerSet([]int{1, 2, 3}))
+	assert.Equal(t, [][]int{{}}, PowerSet([]int{}))
+	assert.Len(t, PowerSet(make([]int, 10)), 1024)
+}
+
+func Test_Combinatorics_EachStopsEarly(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+
+	var calls int
+	stopAt := func(n int) func([]int) bool {
+		calls = 0
+		return func([]int) bool {
+			calls++
+			return calls < n
+		}
+	}
+
+	EachProduct([][]int{s, s, s}, stopAt(5))
+	assert.Equal(t, 5, calls)
+	EachPermutation(s, stopAt(3))
+	assert.Equal(t, 3, calls)
+	EachCombination(s, 2, stopAt(2))
+	assert.Equal(t, 2, calls)
+	// the subsets of size 0 and 1, then the first one of size 2
+	EachSubset(s, stopAt(6))
+	assert.Equal(t, 6, calls)
+	EachSubset(s, stopAt(1))
+	assert.Equal(t, 1, calls)
+}
+
+func Test_Combinatorics_EachReusesTuple(t *testing.T) {
+	s := []int{1, 2, 3}
+
+	var first []int
+	EachPermutation(s, func(perm []int) bool {
+		if first == nil {
+			first = perm
+		}
+		return true
+	})
+	// the retained slice was overwritten by the following permutations
+	assert.Equal(t, []int{3, 2, 1}, first)
+	// the input is never modified
+	assert.Equal(t, []int{1, 2, 3}, s)
+
+	// a large space is walked without holding it in memory
+	count := 0
+	EachPermutation(make([]int, 9), func([]int) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 362880, count)
+}
@@ -0,0 +1,448 @@
+package gofunc
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact fixed-point decimal number, suited to amounts of money where floats
+// would turn 0.1 + 0.2 into 0.30000000000000004. A Decimal is an arbitrary-precision
+// integer scaled by a number of fractional digits, so 19.99 has the value 1999 and the
+// scale 2. Addition, subtraction and multiplication are exact; division and Round take a
+// scale and a RoundingMode.
+//
+// Decimals are immutable values, and the zero value is 0. Compare them with Cmp or Equal
+// rather than ==, which compares their internal representation. Decimal.Cmp can be
+// passed to SortFunc, MinFunc, MaxFunc and NewTreeMapFunc.
+//
+// Example:
+//
+//	price := gofunc.MustParseDecimal("19.99")
+//	total := price.Mul(gofunc.NewDecimal(3, 0))
+//	withTax := total.Mul(gofunc.MustParseDecimal("1.2")).Round(2, gofunc.RoundHalfEven)
+//	// withTax.String() is "71.96"
+type Decimal struct {
+	value *big.Int // nil means 0
+	scale int
+}
+
+// RoundingMode selects how Decimal.Round and Decimal.Div drop digits.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value, and ties to the value with an even last
+	// digit, so 2.5 rounds to 2 and 3.5 to 4. Also known as banker's rounding, it does not
+	// bias sums of rounded values upwards.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, and ties away from zero, so 2.5 rounds to 3
+	// and -2.5 to -3. This is the rounding taught in school.
+	RoundHalfUp
+	// RoundDown drops the extra digits, rounding towards zero.
+	RoundDown
+)
+
+// maxDecimalExponent bounds the exponents accepted by ParseDecimal, so that a short
+// input such as "1e999999999" cannot allocate a huge number.
+const maxDecimalExponent = 1 << 16
+
+// NewDecimal creates the Decimal value*10^-scale, so NewDecimal(1999, 2) is 19.99.
+// Panics with an *ArgumentError if scale is negative.
+//
+// Example:
+//
+//	cents := gofunc.NewDecimal(1999, 2)
+//	// cents.String() is "19.99"
+func NewDecimal(value int64, scale int) Decimal {
+	if scale < 0 {
+		panic(newArgumentError("scale", "must not be negative"))
+	}
+	return Decimal{value: big.NewInt(value), scale: scale}
+}
+
+// ParseDecimal parses a decimal number such as "-12.50", "+3", ".5" or "1.2e3".
+// The scale of the result is the number of digits after the decimal point, adjusted by
+// the exponent, so "12.50" keeps its scale of 2.
+// Returns an *ArgumentError if s is not a decimal number.
+//
+// Example:
+//
+//	d, err := gofunc.ParseDecimal("12.50")
+//	// d.String() is "12.50", d.Scale() is 2, err is nil
+func ParseDecimal(s string) (Decimal, error) {
+	invalid := newArgumentError("s", fmt.Sprintf("%q is not a decimal number", s))
+
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil || exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return Decimal{}, invalid
+		}
+		mantissa, exponent = s[:i], exp
+	}
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, invalid
+	}
+
+	value, _ := new(big.Int).SetString(sign+digits, 10)
+	scale := len(fracPart) - exponent
+	if scale < 0 {
+		value.Mul(value, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{value: value, scale: scale}, nil
+}
+
+// MustParseDecimal parses a decimal number like ParseDecimal, but panics if s is not
+// valid. It is meant for constants known to be valid.
+//
+// Example:
+//
+//	vatRate := gofunc.MustParseDecimal("0.20")
+func MustParseDecimal(s string) Decimal {
+	return Must(ParseDecimal(s))
+}
+
+// DecimalFromFloat converts a float to the shortest Decimal that converts back to the
+// same float, so 0.1 becomes exactly 0.1 rather than the binary value nearest to it.
+// Returns an *ArgumentError if f is NaN or infinite.
+//
+// Example:
+//
+//	d, _ := gofunc.DecimalFromFloat(0.1)
+//	// d.String() is "0.1"
+func DecimalFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, newArgumentError("f", fmt.Sprintf("%v has no decimal value", f))
+	}
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	if d.value == nil {
+		return 0
+	}
+	return d.value.Sign()
+}
+
+// IsZero reports whether d is 0, whatever its scale.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Add returns d+other, exactly. The scale of the result is the larger of both scales.
+//
+// Example:
+//
+//	sum := gofunc.MustParseDecimal("0.1").Add(gofunc.MustParseDecimal("0.25"))
+//	// sum.String() is "0.35"
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, scale := alignDecimals(d, other)
+	return Decimal{value: a.Add(a, b), scale: scale}
+}
+
+// Sub returns d-other, exactly. The scale of the result is the larger of both scales.
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, scale := alignDecimals(d, other)
+	return Decimal{value: a.Sub(a, b), scale: scale}
+}
+
+// Mul returns d*other, exactly. The scale of the result is the sum of both scales,
+// so the result usually needs to be rounded with Round.
+//
+// Example:
+//
+//	total := gofunc.MustParseDecimal("19.99").Mul(gofunc.MustParseDecimal("1.5"))
+//	// total.String() is "29.985"
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{value: new(big.Int).Mul(d.int(), other.int()), scale: d.scale + other.scale}
+}
+
+// Div returns d/other rounded to the given scale with the given rounding mode.
+// Panics with an *ArgumentError if other is zero, like the / operator, or if scale is
+// negative.
+//
+// Example:
+//
+//	third := gofunc.NewDecimal(10, 0).Div(gofunc.NewDecimal(3, 0), 2, gofunc.RoundHalfEven)
+//	// third.String() is "3.33"
+func (d Decimal) Div(other Decimal, scale int, mode RoundingMode) Decimal {
+	if other.IsZero() {
+		panic(newArgumentError("other", "division by zero"))
+	}
+	if scale < 0 {
+		panic(newArgumentError("scale", "must not be negative"))
+	}
+	// d/other = (dv/ov) * 10^(other.scale-d.scale), to be expressed in units of 10^-scale
+	num, den := new(big.Int).Set(d.int()), new(big.Int).Set(other.int())
+	if shift := scale + other.scale - d.scale; shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	return Decimal{value: roundQuo(num, den, mode), scale: scale}
+}
+
+// Round returns d with the given number of digits after the decimal point, rounded with
+// the given rounding mode. A larger scale than the current one pads d with zeros.
+// Panics with an *ArgumentError if scale is negative.
+//
+// Example:
+//
+//	gofunc.MustParseDecimal("2.345").Round(2, gofunc.RoundHalfEven) // 2.34
+//	gofunc.MustParseDecimal("2.345").Round(2, gofunc.RoundHalfUp)   // 2.35
+//	gofunc.MustParseDecimal("2.345").Round(2, gofunc.RoundDown)     // 2.34
+func (d Decimal) Round(scale int, mode RoundingMode) Decimal {
+	if scale < 0 {
+		panic(newArgumentError("scale", "must not be negative"))
+	}
+	if scale >= d.scale {
+		return Decimal{value: new(big.Int).Mul(d.int(), pow10(scale-d.scale)), scale: scale}
+	}
+	return Decimal{value: roundQuo(d.int(), pow10(d.scale-scale), mode), scale: scale}
+}
+
+// Cmp compares d and other numerically, ignoring their scales, and returns -1 if d is
+// less than other, 0 if they are equal and 1 if d is greater. The method expression
+// Decimal.Cmp is a comparator for SortFunc, MinFunc, MaxFunc and NewTreeMapFunc.
+//
+// Example:
+//
+//	gofunc.SortFunc(prices, gofunc.Decimal.Cmp)
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := alignDecimals(d, other)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and other are numerically equal, so 1.5 equals 1.50.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Allocate splits d into parts proportional to the ratios, in units of the last digit of
+// d, such as cents for a scale of 2. The parts always add up to exactly d: the units left
+// over by rounding the parts down go to the parts that lost the largest fractions, and to
+// the first ones on ties. Returns an *ArgumentError if no ratio is given, a ratio is
+// negative, or all the ratios are zero.
+//
+// Example:
+//
+//	parts, _ := gofunc.NewDecimal(100, 2).Allocate(1, 1, 1)
+//	// parts are 0.34, 0.33 and 0.33
+//	parts, _ = gofunc.NewDecimal(500, 2).Allocate(70, 30)
+//	// parts are 3.50 and 1.50
+func (d Decimal) Allocate(ratios ...int) ([]Decimal, error) {
+	if len(ratios) == 0 {
+		return nil, newArgumentError("ratios", "at least one ratio is required")
+	}
+	total := new(big.Int)
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, newArgumentError("ratios", "must not be negative")
+		}
+		total.Add(total, big.NewInt(int64(r)))
+	}
+	if total.Sign() == 0 {
+		return nil, newArgumentError("ratios", "must not all be zero")
+	}
+
+	// split the absolute value, so that rounding down is the same for negative amounts
+	units := new(big.Int).Abs(d.int())
+	shares := make([]*big.Int, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	left := new(big.Int).Set(units)
+	for i, r := range ratios {
+		shares[i], remainders[i] = new(big.Int).QuoRem(new(big.Int).Mul(units, big.NewInt(int64(r))), total, new(big.Int))
+		left.Sub(left, shares[i])
+	}
+	// fewer units are left over than there are parts, so each part gets at most one
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	SortFunc(order, func(a, b int) int {
+		if c := remainders[b].Cmp(remainders[a]); c != 0 {
+			return c
+		}
+		return compareOrdered(a, b)
+	})
+	for _, i := range order[:left.Int64()] {
+		shares[i].Add(shares[i], big.NewInt(1))
+	}
+
+	parts := make([]Decimal, len(ratios))
+	for i, share := range shares {
+		if d.Sign() < 0 {
+			share.Neg(share)
+		}
+		parts[i] = Decimal{value: share, scale: d.scale}
+	}
+	return parts, nil
+}
+
+// Split splits d into n parts as equal as possible, that add up to exactly d.
+// It is Allocate with n equal ratios. Returns an *ArgumentError if n is not positive.
+//
+// Example:
+//
+//	parts, _ := gofunc.NewDecimal(1000, 2).Split(3)
+//	// parts are 3.34, 3.33 and 3.33
+func (d Decimal) Split(n int) ([]Decimal, error) {
+	if n <= 0 {
+		return nil, newArgumentError("n", "must be positive")
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return d.Allocate(ratios...)
+}
+
+// Float64 returns the float nearest to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String formats d with exactly Scale digits after the decimal point, such as "-12.50".
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+	var sb strings.Builder
+	if d.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(digits[:len(digits)-d.scale])
+	if d.scale > 0 {
+		sb.WriteByte('.')
+		sb.WriteString(digits[len(digits)-d.scale:])
+	}
+	return sb.String()
+}
+
+// MarshalJSON encodes d as a JSON string such as "12.50", implementing json.Marshaler.
+// A string keeps every digit, where JSON numbers are often decoded as floats.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes a JSON string or number, implementing json.Unmarshaler.
+// JSON null leaves d unchanged, like it does for the other types.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value encodes d as a string for a database, implementing driver.Valuer. Databases
+// convert strings to their NUMERIC and DECIMAL columns without losing digits.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan decodes a value read from a database, implementing sql.Scanner. It accepts the
+// strings and bytes of NUMERIC and DECIMAL columns, integers and floats.
+// Returns an *ArgumentError for NULL and other types.
+func (d *Decimal) Scan(src interface{}) error {
+	var (
+		parsed Decimal
+		err    error
+	)
+	switch v := src.(type) {
+	case string:
+		parsed, err = ParseDecimal(v)
+	case []byte:
+		parsed, err = ParseDecimal(string(v))
+	case int64:
+		parsed = NewDecimal(v, 0)
+	case float64:
+		parsed, err = DecimalFromFloat(v)
+	default:
+		err = newArgumentError("src", fmt.Sprintf("cannot scan %T into a Decimal", src))
+	}
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// int returns the unscaled value of d, which must not be modified.
+func (d Decimal) int() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return d.value
+}
+
+// alignDecimals returns new copies of the unscaled values of a and b, brought to the same
+// scale, and that scale.
+func alignDecimals(a, b Decimal) (*big.Int, *big.Int, int) {
+	x, y := new(big.Int).Set(a.int()), new(big.Int).Set(b.int())
+	switch {
+	case a.scale < b.scale:
+		x.Mul(x, pow10(b.scale-a.scale))
+	case a.scale > b.scale:
+		y.Mul(y, pow10(a.scale-b.scale))
+	}
+	return x, y, maxOf2(a.scale, b.scale)
+}
+
+// roundQuo returns num/den rounded to an integer with the given rounding mode.
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 || mode == RoundDown {
+		return q
+	}
+	// compare the dropped fraction |r/den| to one half
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	c := half.Cmp(new(big.Int).Abs(den))
+	if c > 0 || (c == 0 && (mode == RoundHalfUp || q.Bit(0) == 1)) {
+		// QuoRem truncates towards zero, so rounding up moves away from zero
+		q.Add(q, big.NewInt(int64(num.Sign()*den.Sign())))
+	}
+	return q
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,226 @@
+package gofunc
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Decimal_Parse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		scale int
+	}{
+		{"12.50", "12.50", 2},
+		{"-0.005", "-0.005", 3},
+		{"+3", "3", 0},
+		{".5", "0.5", 1},
+		{"7.", "7", 0},
+		{"-0", "0", 0},
+		{"1.2e3", "1200", 0},
+		{"1.25E-2", "0.0125", 4},
+		{"123456789012345678901234567890.01", "123456789012345678901234567890.01", 2},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.input)
+		assert.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, d.String(), tt.input)
+		assert.Equal(t, tt.scale, d.Scale(), tt.input)
+	}
+
+	for _, input := range []string{"", "-", ".", "1.2.3", "1,5", " 1", "1e", "e5", "0x10", "1e999999", "NaN"} {
+		_, err := ParseDecimal(input)
+		assert.ErrorIs(t, err, ErrInvalidArgument, input)
+	}
+	assert.Panics(t, func() { MustParseDecimal("abc") })
+
+	assert.Equal(t, "19.99", NewDecimal(1999, 2).String())
+	assert.Equal(t, "-0.07", NewDecimal(-7, 2).String())
+	assert.Panics(t, func() { NewDecimal(1, -1) })
+
+	var zero Decimal
+	assert.Equal(t, "0", zero.String())
+	assert.True(t, zero.IsZero())
+	assert.Equal(t, "1.5", zero.Add(MustParseDecimal("1.5")).String())
+}
+
+func Test_Decimal_FromFloat(t *testing.T) {
+	d, err := DecimalFromFloat(0.1)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", d.String())
+	d, err = DecimalFromFloat(-1234.5)
+	assert.NoError(t, err)
+	assert.Equal(t, "-1234.5", d.String())
+	assert.Equal(t, -1234.5, d.Float64())
+
+	_, err = DecimalFromFloat(math.NaN())
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = DecimalFromFloat(math.Inf(-1))
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func Test_Decimal_Arithmetic(t *testing.T) {
+	a, b := MustParseDecimal("0.1"), MustParseDecimal("0.2")
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+	assert.Equal(t, "10.00", MustParseDecimal("10").Add(MustParseDecimal("0.00")).String())
+	assert.Equal(t, "29.985", MustParseDecimal("19.99").Mul(MustParseDecimal("1.5")).String())
+
+	assert.Equal(t, "-2.50", MustParseDecimal("2.50").Neg().String())
+	assert.Equal(t, "2.50", MustParseDecimal("-2.50").Abs().String())
+	assert.Equal(t, -1, MustParseDecimal("-0.01").Sign())
+	assert.Equal(t, 1, MustParseDecimal("0.01").Sign())
+	assert.True(t, MustParseDecimal("0.000").IsZero())
+
+	// the operands are never modified
+	assert.Equal(t, "0.1", a.String())
+	assert.Equal(t, "0.2", b.String())
+}
+
+func Test_Decimal_Round(t *testing.T) {
+	tests := []struct {
+		input                  string
+		halfEven, halfUp, down string
+	}{
+		{"2.345", "2.34", "2.35", "2.34"},
+		{"2.355", "2.36", "2.36", "2.35"},
+		{"-2.345", "-2.34", "-2.35", "-2.34"},
+		{"2.3451", "2.35", "2.35", "2.34"},
+		{"-2.3449", "-2.34", "-2.34", "-2.34"},
+		{"2.3", "2.30", "2.30", "2.30"},
+	}
+	for _, tt := range tests {
+		d := MustParseDecimal(tt.input)
+		assert.Equal(t, tt.halfEven, d.Round(2, RoundHalfEven).String(), tt.input)
+		assert.Equal(t, tt.halfUp, d.Round(2, RoundHalfUp).String(), tt.input)
+		assert.Equal(t, tt.down, d.Round(2, RoundDown).String(), tt.input)
+	}
+	assert.Equal(t, "2", MustParseDecimal("2.5").Round(0, RoundHalfEven).String())
+	assert.Equal(t, "4", MustParseDecimal("3.5").Round(0, RoundHalfEven).String())
+	assert.Panics(t, func() { MustParseDecimal("1").Round(-1, RoundDown) })
+}
+
+func Test_Decimal_Div(t *testing.T) {
+	ten, three := NewDecimal(10, 0), NewDecimal(3, 0)
+	assert.Equal(t, "3.33", ten.Div(three, 2, RoundHalfEven).String())
+	assert.Equal(t, "-3.33", ten.Neg().Div(three, 2, RoundHalfUp).String())
+	assert.Equal(t, "6.67", NewDecimal(20, 0).Div(three, 2, RoundHalfUp).String())
+	assert.Equal(t, "6.66", NewDecimal(20, 0).Div(three, 2, RoundDown).String())
+	assert.Equal(t, "-6.67", NewDecimal(20, 0).Div(three.Neg(), 2, RoundHalfEven).String())
+	assert.Equal(t, "0.125", NewDecimal(1, 0).Div(NewDecimal(8, 0), 3, RoundDown).String())
+	// the scales of the operands do not matter
+	assert.Equal(t, "12", MustParseDecimal("1.2").Div(MustParseDecimal("0.100"), 0, RoundDown).String())
+	assert.Equal(t, "4", MustParseDecimal("1000").Div(MustParseDecimal("250.00"), 0, RoundDown).String())
+	// ties are decided exactly
+	assert.Equal(t, "0.2", NewDecimal(1, 0).Div(NewDecimal(4, 0), 1, RoundHalfEven).String())
+	assert.Equal(t, "0.3", NewDecimal(1, 0).Div(NewDecimal(4, 0), 1, RoundHalfUp).String())
+
+	assert.Panics(t, func() { ten.Div(Decimal{}, 2, RoundDown) })
+	assert.Panics(t, func() { ten.Div(three, -1, RoundDown) })
+}
+
+func Test_Decimal_Compare(t *testing.T) {
+	assert.Equal(t, 0, MustParseDecimal("1.5").Cmp(MustParseDecimal("1.500")))
+	assert.True(t, MustParseDecimal("1.5").Equal(MustParseDecimal("1.50")))
+	assert.Equal(t, -1, MustParseDecimal("-2").Cmp(MustParseDecimal("1.99")))
+	assert.Equal(t, 1, MustParseDecimal("0.001").Cmp(Decimal{}))
+
+	prices := []Decimal{MustParseDecimal("9.99"), MustParseDecimal("-1"), MustParseDecimal("4.5")}
+	assert.Equal(t, "-1", Must(MinFunc(prices, Decimal.Cmp)).String())
+	assert.Equal(t, "9.99", Must(MaxFunc(prices, Decimal.Cmp)).String())
+	SortFunc(prices, Decimal.Cmp)
+	assert.Equal(t, []string{"-1", "4.5", "9.99"}, decimalStrings(prices))
+
+	m := NewTreeMapFunc[Decimal, string](Decimal.Cmp)
+	m.Put(MustParseDecimal("1.0"), "one")
+	v, ok := m.Get(MustParseDecimal("1"))
+	assert.True(t, ok)
+	assert.Equal(t, "one", v)
+}
+
+func Test_Decimal_Allocate(t *testing.T) {
+	strs := func(parts []Decimal, err error) []string {
+		assert.NoError(t, err)
+		return decimalStrings(parts)
+	}
+	assert.Equal(t, []string{"0.34", "0.33", "0.33"}, strs(NewDecimal(100, 2).Allocate(1, 1, 1)))
+	assert.Equal(t, []string{"3.50", "1.50"}, strs(NewDecimal(500, 2).Allocate(70, 30)))
+	assert.Equal(t, []string{"0.03", "0.02", "0.00"}, strs(NewDecimal(5, 2).Allocate(1, 1, 0)))
+	// leftover units go to the largest fractions: 0.05 split 3:7 is 0.015 and 0.035
+	assert.Equal(t, []string{"0.02", "0.03"}, strs(NewDecimal(5, 2).Allocate(3, 7)))
+	assert.Equal(t, []string{"-3.34", "-3.33", "-3.33"}, strs(NewDecimal(-1000, 2).Split(3)))
+	assert.Equal(t, []string{"0", "0"}, strs(Decimal{}.Split(2)))
+
+	parts, err := MustParseDecimal("1234.57").Allocate(17, 0, 23, 5, 1)
+	assert.NoError(t, err)
+	var sum Decimal
+	for _, part := range parts {
+		sum = sum.Add(part)
+	}
+	assert.Equal(t, "1234.57", sum.String())
+	assert.True(t, parts[1].IsZero())
+
+	_, err = NewDecimal(1, 0).Allocate()
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = NewDecimal(1, 0).Allocate(1, -1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = NewDecimal(1, 0).Allocate(0, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = NewDecimal(1, 0).Split(0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func Test_Decimal_JSON(t *testing.T) {
+	type invoice struct {
+		Total Decimal  `json:"total"`
+		Tax   *Decimal `json:"tax"`
+	}
+	data, err := json.Marshal(invoice{Total: MustParseDecimal("12.50")})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"total": "12.50", "tax": null}`, string(data))
+
+	var decoded invoice
+	assert.NoError(t, json.Unmarshal([]byte(`{"total": "12.50", "tax": 0.1}`), &decoded))
+	assert.Equal(t, "12.50", decoded.Total.String())
+	assert.Equal(t, "0.1", decoded.Tax.String())
+	assert.NoError(t, json.Unmarshal([]byte(`{"total": 1e2}`), &decoded))
+	assert.Equal(t, "100", decoded.Total.String())
+	assert.NoError(t, json.Unmarshal([]byte(`{"total": null}`), &decoded))
+	assert.Equal(t, "100", decoded.Total.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"total": "abc"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"total": true}`), &decoded))
+}
+
+func Test_Decimal_SQL(t *testing.T) {
+	v, err := MustParseDecimal("-0.50").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.50", v)
+
+	var d Decimal
+	assert.NoError(t, d.Scan("12.345"))
+	assert.Equal(t, "12.345", d.String())
+	assert.NoError(t, d.Scan([]byte("0.10")))
+	assert.Equal(t, "0.10", d.String())
+	assert.NoError(t, d.Scan(int64(-42)))
+	assert.Equal(t, "-42", d.String())
+	assert.NoError(t, d.Scan(2.5))
+	assert.Equal(t, "2.5", d.String())
+
+	assert.ErrorIs(t, d.Scan(nil), ErrInvalidArgument)
+	assert.ErrorIs(t, d.Scan(true), ErrInvalidArgument)
+	assert.ErrorIs(t, d.Scan("1,5"), ErrInvalidArgument)
+	assert.Equal(t, "2.5", d.String())
+}
+
+func decimalStrings(ds []Decimal) []string {
+	result := make([]string, len(ds))
+	for i, d := range ds {
+		result[i] = d.String()
+	}
+	return result
+}
@@ -0,0 +1,196 @@
+package gofunc
+
+// DisjointSet is a union-find structure partitioning comparable elements into disjoint sets.
+// It uses path compression and union by rank, so Find and Union run in nearly constant
+// amortized time. Elements are added implicitly by Union, or explicitly by Add.
+// The zero value is not usable; create a DisjointSet with NewDisjointSet.
+//
+// Example:
+//
+//	ds := gofunc.NewDisjointSet[string]()
+//	ds.Union("a", "b")
+//	ds.Union("b", "c")
+//	// ds.Connected("a", "c") is true
+type DisjointSet[T comparable] struct {
+	index  map[T]int
+	items  []T
+	parent []int
+	rank   []uint8
+	sets   int
+}
+
+// NewDisjointSet creates an empty DisjointSet.
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{index: make(map[T]int)}
+}
+
+// Len returns the number of elements in the DisjointSet.
+func (d *DisjointSet[T]) Len() int {
+	return len(d.items)
+}
+
+// Sets returns the number of disjoint sets.
+func (d *DisjointSet[T]) Sets() int {
+	return d.sets
+}
+
+// Add adds an element as a new singleton set.
+// Returns true if the element was not present before.
+func (d *DisjointSet[T]) Add(x T) bool {
+	if _, ok := d.index[x]; ok {
+		return false
+	}
+	d.add(x)
+	return true
+}
+
+func (d *DisjointSet[T]) add(x T) int {
+	if i, ok := d.index[x]; ok {
+		return i
+	}
+	i := len(d.items)
+	d.index[x] = i
+	d.items = append(d.items, x)
+	d.parent = append(d.parent, i)
+	d.rank = append(d.rank, 0)
+	d.sets++
+	return i
+}
+
+// Find returns the representative element of the set containing x.
+// Two elements belong to the same set if and only if they have the same representative.
+// Returns the zero value and false if x is not in the DisjointSet.
+func (d *DisjointSet[T]) Find(x T) (T, bool) {
+	i, ok := d.index[x]
+	if !ok {
+		var zeroT T
+		return zeroT, false
+	}
+	return d.items[d.root(i)], true
+}
+
+func (d *DisjointSet[T]) root(i int) int {
+	r := i
+	for d.parent[r] != r {
+		r = d.parent[r]
+	}
+	// path compression: point every node on the way directly at the root
+	for d.parent[i] != r {
+		d.parent[i], i = r, d.parent[i]
+	}
+	return r
+}
+
+// Union merges the sets containing a and b, adding either element first if needed.
+// Returns true if the elements were in different sets before.
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	return d.union(d.add(a), d.add(b))
+}
+
+func (d *DisjointSet[T]) union(i, j int) bool {
+	ri, rj := d.root(i), d.root(j)
+	if ri == rj {
+		return false
+	}
+	// attach the shallower tree under the deeper one to keep trees flat
+	switch {
+	case d.rank[ri] < d.rank[rj]:
+		d.parent[ri] = rj
+	case d.rank[ri] > d.rank[rj]:
+		d.parent[rj] = ri
+	default:
+		d.parent[rj] = ri
+		d.rank[ri]++
+	}
+	d.sets--
+	return true
+}
+
+// Connected reports whether a and b belong to the same set.
+// Returns false if either element is not in the DisjointSet.
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	i, ok := d.index[a]
+	if !ok {
+		return false
+	}
+	j, ok := d.index[b]
+	if !ok {
+		return false
+	}
+	return d.root(i) == d.root(j)
+}
+
+// Groups returns the elements of every set.
+// Sets are ordered by their first added element, and elements within a set keep insertion order.
+func (d *DisjointSet[T]) Groups() [][]T {
+	group := make(map[int]int, d.sets)
+	result := make([][]T, 0, d.sets)
+	for i, x := range d.items {
+		r := d.root(i)
+		g, ok := group[r]
+		if !ok {
+			g = len(result)
+			group[r] = g
+			result = append(result, []T{})
+		}
+		result[g] = append(result[g], x)
+	}
+	return result
+}
+
+// ClusterBy groups the elements of a slice that are linked, directly or transitively,
+// through any of the key functions. Two elements are linked when one key function returns
+// the same key for both, so if A matches B by email and B matches C by phone, A, B and C
+// end up in the same cluster. Keys from different key functions are never compared.
+// Clusters are ordered by their first element, and elements within a cluster keep their
+// original order. The input slice is not modified.
+//
+// Note that elements sharing a placeholder key, such as an empty email, are linked too;
+// return a distinct key for them, for instance derived from another field, to avoid this.
+//
+// Example:
+//
+//	type Customer struct { ID int; Email, Phone string }
+//	customers := []Customer{
+//		{1, "a@x.com", "111"},
+//		{2, "b@x.com", "111"},
+//		{3, "b@x.com", "222"},
+//		{4, "c@x.com", "333"},
+//	}
+//	clusters := gofunc.ClusterBy(customers,
+//		func(c Customer) string { return c.Email },
+//		func(c Customer) string { return c.Phone },
+//	)
+//	// clusters is [][]Customer{{1, 2, 3}, {4}} by ID
+func ClusterBy[T any, K comparable](s []T, keyFuncs ...func(t T) K) [][]T {
+	var (
+		length = len(s)
+		ds     = NewDisjointSet[int]()
+		seen   = make([]map[K]int, len(keyFuncs))
+	)
+	for j := range seen {
+		seen[j] = make(map[K]int, length)
+	}
+
+	for i := 0; i < length; i++ {
+		ds.add(i)
+		for j, keyFunc := range keyFuncs {
+			k := keyFunc(s[i])
+			if first, ok := seen[j][k]; ok {
+				ds.union(first, i)
+				continue
+			}
+			seen[j][k] = i
+		}
+	}
+
+	groups := ds.Groups()
+	result := make([][]T, len(groups))
+	for g, indexes := range groups {
+		result[g] = make([]T, len(indexes))
+		for i, index := range indexes {
+			result[g][i] = s[index]
+		}
+	}
+	return result
+}
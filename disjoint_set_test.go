@@ -0,0 +1,118 @@
+package gofunc
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DisjointSet(t *testing.T) {
+	ds := NewDisjointSet[string]()
+	assert.Equal(t, 0, ds.Len())
+	assert.Equal(t, 0, ds.Sets())
+
+	assert.True(t, ds.Add("a"))
+	assert.False(t, ds.Add("a"))
+	assert.True(t, ds.Union("b", "c"))
+	assert.False(t, ds.Union("c", "b"))
+	assert.Equal(t, 3, ds.Len())
+	assert.Equal(t, 2, ds.Sets())
+
+	assert.True(t, ds.Connected("b", "c"))
+	assert.False(t, ds.Connected("a", "b"))
+	assert.False(t, ds.Connected("a", "z"))
+	assert.False(t, ds.Connected("z", "a"))
+
+	rb, ok := ds.Find("b")
+	assert.True(t, ok)
+	rc, _ := ds.Find("c")
+	assert.Equal(t, rb, rc)
+	_, ok = ds.Find("z")
+	assert.False(t, ok)
+
+	assert.True(t, ds.Union("d", "a"))
+	assert.True(t, ds.Union("c", "d"))
+	assert.True(t, ds.Connected("a", "b"))
+	assert.Equal(t, 1, ds.Sets())
+	assert.Equal(t, [][]string{{"a", "b", "c", "d"}}, ds.Groups())
+}
+
+func Test_DisjointSet_Groups(t *testing.T) {
+	ds := NewDisjointSet[int]()
+	assert.Equal(t, [][]int{}, ds.Groups())
+
+	for i := 0; i < 10; i++ {
+		ds.Add(i)
+	}
+	ds.Union(9, 1)
+	ds.Union(3, 5)
+	ds.Union(5, 7)
+	ds.Union(1, 3)
+	ds.Union(2, 4)
+	assert.Equal(t, [][]int{{0}, {1, 3, 5, 7, 9}, {2, 4}, {6}, {8}}, ds.Groups())
+	assert.Equal(t, 5, ds.Sets())
+}
+
+func Test_DisjointSet_RandomizedAgainstGraph(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ds := NewDisjointSet[int]()
+	g := NewUndirectedGraph[int]()
+	for i := 0; i < 200; i++ {
+		ds.Add(i)
+		g.AddNode(i)
+	}
+	for i := 0; i < 150; i++ {
+		a, b := r.Intn(200), r.Intn(200)
+		ds.Union(a, b)
+		g.AddEdge(a, b)
+	}
+
+	assert.Equal(t, g.ConnectedComponents(), ds.Groups())
+	// Ranks stay logarithmic thanks to union by rank
+	for _, rank := range ds.rank {
+		assert.LessOrEqual(t, rank, uint8(8))
+	}
+}
+
+func Test_ClusterBy(t *testing.T) {
+	type Customer struct {
+		ID    int
+		Email string
+		Phone string
+	}
+	customers := []Customer{
+		{1, "a@x.com", "111"},
+		{2, "b@x.com", "111"},
+		{3, "c@x.com", "333"},
+		{4, "b@x.com", "222"},
+		{5, "d@x.com", "333"},
+		{6, "e@x.com", "666"},
+	}
+	email := func(c Customer) string { return c.Email }
+	phone := func(c Customer) string { return c.Phone }
+
+	ids := func(clusters [][]Customer) [][]int {
+		result := [][]int{}
+		for _, cluster := range clusters {
+			result = append(result, []int{})
+			for _, c := range cluster {
+				result[len(result)-1] = append(result[len(result)-1], c.ID)
+			}
+		}
+		return result
+	}
+
+	assert.Equal(t, [][]int{{1, 2, 4}, {3, 5}, {6}}, ids(ClusterBy(customers, email, phone)))
+	assert.Equal(t, [][]int{{1}, {2, 4}, {3}, {5}, {6}}, ids(ClusterBy(customers, email)))
+	assert.Equal(t, [][]int{{1}, {2}, {3}, {4}, {5}, {6}}, ids(ClusterBy[Customer, string](customers)))
+	assert.Equal(t, [][]Customer{}, ClusterBy([]Customer{}, email, phone))
+
+	// Keys from different key functions are not compared with each other
+	values := []string{"x", "y"}
+	clusters := ClusterBy(values,
+		func(s string) string { return s },
+		func(s string) string { return map[string]string{"x": "y", "y": "x"}[s] },
+	)
+	assert.Equal(t, [][]string{{"x"}, {"y"}}, clusters)
+}
@@ -1,7 +1,208 @@
 package gofunc
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
-	ErrInputRequired = errors.New("input is required")
+	ErrEmpty           = errors.New("empty input")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrOverflow        = errors.New("overflow")
+	ErrIndexOutOfRange = errors.New("index out of range")
+	ErrCycle           = errors.New("graph contains a cycle")
+	ErrCircuitOpen     = errors.New("circuit breaker is open")
+	ErrBulkheadFull    = errors.New("bulkhead is full")
+	ErrClosed          = errors.New("use of a closed value")
+	ErrLossyConversion = errors.New("numeric conversion loses information")
+
+	// ErrInputRequired is returned by Min, Max and similar functions when no input is given.
+	// It matches ErrEmpty with errors.Is.
+	ErrInputRequired error = &kindError{msg: "input is required", kind: ErrEmpty}
 )
+
+// kindError is a sentinel error that also matches a more general sentinel.
+type kindError struct {
+	msg  string
+	kind error
+}
+
+func (e *kindError) Error() string {
+	return e.msg
+}
+
+func (e *kindError) Unwrap() error {
+	return e.kind
+}
+
+// ArgumentError reports an invalid function argument.
+// Name is the name of the argument and Reason describes what is wrong with it.
+// It matches ErrInvalidArgument with errors.Is.
+//
+// Example:
+//
+//	_, err := gofunc.TryChunkSlice(items, 0)
+//	var argErr *gofunc.ArgumentError
+//	if errors.As(err, &argErr) {
+//		// argErr.Name is "chunkSize"
+//	}
+type ArgumentError struct {
+	Name   string
+	Reason string
+}
+
+func (e *ArgumentError) Error() string {
+	return fmt.Sprintf("%s %s: %s", ErrInvalidArgument, e.Name, e.Reason)
+}
+
+func (e *ArgumentError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// IndexError reports an index outside of the valid range [0, Length).
+// It matches ErrIndexOutOfRange with errors.Is.
+type IndexError struct {
+	Index  int
+	Length int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("%s: index %d with length %d", ErrIndexOutOfRange, e.Index, e.Length)
+}
+
+func (e *IndexError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
+}
+
+// CycleError is returned by Graph.TopologicalSort when the graph contains a cycle.
+// Cycle lists the nodes along the cycle, starting and ending with the same node.
+// It matches ErrCycle with errors.Is.
+type CycleError[N comparable] struct {
+	Cycle []N
+}
+
+func (e *CycleError[N]) Error() string {
+	return fmt.Sprintf("%s: %v", ErrCycle, e.Cycle)
+}
+
+func (e *CycleError[N]) Is(target error) bool {
+	return target == ErrCycle
+}
+
+// RetryError is returned by Retry and RetryValue when they give up on a retryable error.
+// It unwraps to the last error returned by the operation and, if the context ended the
+// retries, to the context error as well.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("giving up after %d attempt(s): %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError wraps a value recovered from a panic by Try, TaskGroup or Async.
+// If the value is an error, it can be reached with errors.Is and errors.As.
+type PanicError struct {
+	Value interface{}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// MultiError aggregates several errors into one.
+// It supports errors.Is and errors.As, which match any of the aggregated errors.
+// A nil *MultiError is valid and empty, so errors can be accumulated without initialization.
+//
+// Example:
+//
+//	var errs *gofunc.MultiError
+//	for _, item := range items {
+//		errs = errs.Append(validate(item))
+//	}
+//	return errs.ErrorOrNil()
+type MultiError struct {
+	Errors []error
+}
+
+// Append adds the non-nil errors to the MultiError and returns it.
+// Errors that are themselves MultiErrors are flattened.
+// A new MultiError is allocated if m is nil and at least one error is non-nil.
+func (m *MultiError) Append(errs ...error) *MultiError {
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if m == nil {
+			m = &MultiError{}
+		}
+		if nested, ok := err.(*MultiError); ok {
+			m.Errors = append(m.Errors, nested.Errors...)
+			continue
+		}
+		m.Errors = append(m.Errors, err)
+	}
+	return m
+}
+
+// Len returns the number of aggregated errors.
+func (m *MultiError) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.Errors)
+}
+
+// ErrorOrNil returns the MultiError as an error, or nil if it holds no errors.
+// This avoids returning a non-nil error interface holding an empty MultiError.
+func (m *MultiError) ErrorOrNil() error {
+	if m.Len() == 0 {
+		return nil
+	}
+	return m
+}
+
+func (m *MultiError) Error() string {
+	if len(m.Errors) == 1 {
+		return m.Errors[0].Error()
+	}
+	messages := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(m.Errors), strings.Join(messages, "; "))
+}
+
+func (m *MultiError) Unwrap() []error {
+	return m.Errors
+}
+
+// Combine merges the given errors into a single error, ignoring nil values.
+// Returns nil if every error is nil, the error itself if only one is non-nil,
+// and a *MultiError otherwise.
+//
+// Example:
+//
+//	err := gofunc.Combine(file.Close(), conn.Close())
+func Combine(errs ...error) error {
+	m := (*MultiError)(nil).Append(errs...)
+	if m.Len() == 1 {
+		return m.Errors[0]
+	}
+	return m.ErrorOrNil()
+}
+
+func newArgumentError(name, reason string) error {
+	return &ArgumentError{Name: name, Reason: reason}
+}
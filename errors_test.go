@@ -0,0 +1,83 @@
+package gofunc
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Errors_Sentinels(t *testing.T) {
+	assert.ErrorIs(t, ErrInputRequired, ErrEmpty)
+	assert.Equal(t, "input is required", ErrInputRequired.Error())
+
+	_, e := Min[int]()
+	assert.ErrorIs(t, e, ErrEmpty)
+}
+
+func Test_Errors_ArgumentError(t *testing.T) {
+	var e error = &ArgumentError{Name: "size", Reason: "must be positive"}
+	assert.ErrorIs(t, e, ErrInvalidArgument)
+	assert.NotErrorIs(t, e, ErrOverflow)
+	assert.Equal(t, "invalid argument size: must be positive", e.Error())
+
+	var argErr *ArgumentError
+	assert.True(t, errors.As(e, &argErr))
+	assert.Equal(t, "size", argErr.Name)
+}
+
+func Test_Errors_IndexError(t *testing.T) {
+	var e error = &IndexError{Index: 5, Length: 3}
+	assert.ErrorIs(t, e, ErrIndexOutOfRange)
+	assert.Equal(t, "index out of range: index 5 with length 3", e.Error())
+}
+
+func Test_Errors_PanicError(t *testing.T) {
+	e := &PanicError{Value: io.EOF}
+	assert.ErrorIs(t, e, io.EOF)
+	assert.Equal(t, "panic: EOF", e.Error())
+
+	e = &PanicError{Value: "boom"}
+	assert.Nil(t, e.Unwrap())
+	assert.Equal(t, "panic: boom", e.Error())
+}
+
+func Test_Errors_MultiError(t *testing.T) {
+	var m *MultiError
+	assert.Equal(t, 0, m.Len())
+	assert.Nil(t, m.ErrorOrNil())
+
+	m = m.Append(nil, nil)
+	assert.Nil(t, m)
+
+	m = m.Append(io.EOF, nil, ErrOverflow)
+	assert.Equal(t, 2, m.Len())
+	e := m.ErrorOrNil()
+	assert.ErrorIs(t, e, io.EOF)
+	assert.ErrorIs(t, e, ErrOverflow)
+	assert.NotErrorIs(t, e, ErrEmpty)
+	assert.Equal(t, "2 errors occurred: EOF; overflow", e.Error())
+
+	// Nested MultiErrors are flattened
+	other := (&MultiError{}).Append(ErrEmpty, &ArgumentError{Name: "x", Reason: "bad"})
+	m = m.Append(other)
+	assert.Equal(t, []error{io.EOF, ErrOverflow, ErrEmpty, other.Errors[1]}, m.Errors)
+	var argErr *ArgumentError
+	assert.True(t, errors.As(m, &argErr))
+
+	single := (&MultiError{}).Append(io.EOF)
+	assert.Equal(t, "EOF", single.Error())
+	assert.Nil(t, (&MultiError{}).ErrorOrNil())
+}
+
+func Test_Errors_Combine(t *testing.T) {
+	assert.Nil(t, Combine())
+	assert.Nil(t, Combine(nil, nil))
+	assert.Equal(t, io.EOF, Combine(nil, io.EOF))
+
+	e := Combine(io.EOF, nil, ErrOverflow)
+	var m *MultiError
+	assert.True(t, errors.As(e, &m))
+	assert.Equal(t, 2, m.Len())
+}
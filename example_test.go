@@ -2,6 +2,8 @@ package gofunc_test
 
 import (
 	"fmt"
+	"math/rand"
+	"strconv"
 
 	"github.com/kingrain94/gofunc"
 )
@@ -261,3 +263,207 @@ func ExampleToNumberSlice() {
 	fmt.Printf("Numbers: %v\n", numbers)
 	// Output: Numbers: [1 2 3]
 }
+
+func ExampleToBitset() {
+	ids := []int{42, 7, 42, 3, 7}
+	set := gofunc.ToBitset(ids)
+	fmt.Printf("Count: %d, Has 7: %t\n", set.Count(), set.Test(7))
+	fmt.Println(gofunc.BitsetToSlice[int](set))
+	// Output: Count: 3, Has 7: true
+	// [3 7 42]
+}
+
+func ExampleTrie() {
+	type Username string
+	users := gofunc.NewTrie[Username, int]()
+	users.Insert("alice", 1)
+	users.Insert("alex", 2)
+	users.Insert("bob", 3)
+
+	fmt.Printf("Starting with 'al': %d\n", users.CountPrefix("al"))
+	users.WalkPrefix("al", func(name Username, id int) bool {
+		fmt.Printf("%s (%d)\n", name, id)
+		return true
+	})
+	// Output: Starting with 'al': 2
+	// alex (2)
+	// alice (1)
+}
+
+func ExampleTreeMap() {
+	prices := gofunc.NewTreeMap[int, string]()
+	prices.Put(100, "basic")
+	prices.Put(250, "pro")
+	prices.Put(500, "enterprise")
+
+	_, plan, _ := prices.Floor(300)
+	fmt.Printf("Best plan for 300: %s\n", plan)
+	prices.Range(100, 500, func(price int, plan string) bool {
+		fmt.Printf("%d: %s\n", price, plan)
+		return true
+	})
+	// Output: Best plan for 300: pro
+	// 100: basic
+	// 250: pro
+}
+
+func ExampleMergeIntervals() {
+	windows := []gofunc.Interval[int]{{Start: 9, End: 11}, {Start: 14, End: 16}, {Start: 10, End: 12}}
+	merged := gofunc.MergeIntervals(windows)
+	fmt.Println(merged)
+	// Output: [{9 12} {14 16}]
+}
+
+func ExampleRange() {
+	fmt.Println(gofunc.Range(0, 10, 3))
+	fmt.Println(gofunc.ChunkSlice(gofunc.Range(1, 6, 1), 2))
+	// Output: [0 3 6 9]
+	// [[1 2] [3 4] [5]]
+}
+
+func ExampleGraph_TopologicalSort() {
+	deps := gofunc.NewGraph[string]()
+	deps.AddEdge("db", "api")
+	deps.AddEdge("cache", "api")
+	deps.AddEdge("api", "web")
+
+	order, err := deps.TopologicalSort()
+	fmt.Println(order, err)
+
+	deps.AddEdge("web", "db")
+	_, err = deps.TopologicalSort()
+	fmt.Println(err)
+	// Output: [cache db api web] <nil>
+	// graph contains a cycle: [db api web db]
+}
+
+func ExampleClusterBy() {
+	type Customer struct {
+		ID    int
+		Email string
+		Phone string
+	}
+	customers := []Customer{
+		{1, "a@x.com", "111"},
+		{2, "b@x.com", "111"},
+		{3, "b@x.com", "222"},
+		{4, "c@x.com", "333"},
+	}
+
+	clusters := gofunc.ClusterBy(customers,
+		func(c Customer) string { return c.Email },
+		func(c Customer) string { return c.Phone },
+	)
+	for _, cluster := range clusters {
+		fmt.Println(len(cluster), "record(s), first ID", cluster[0].ID)
+	}
+	// Output: 3 record(s), first ID 1
+	// 1 record(s), first ID 4
+}
+
+func ExampleOptionOf() {
+	numbers := []int{1, 3, 5}
+	even := gofunc.OptionOf(gofunc.FindPred(numbers, func(n int) bool { return n%2 == 0 }))
+	fmt.Println(even.IsNone(), even.OrElse(-1))
+	// Output: true -1
+}
+
+func ExampleTryMap() {
+	numbers, err := gofunc.TryMap([]string{"1", "2", "3"}, strconv.Atoi)
+	fmt.Println(numbers, err)
+
+	numbers, err = gofunc.TryMapCollect([]string{"1", "x", "3"}, strconv.Atoi)
+	fmt.Println(numbers, err)
+	// Output: [1 2 3] <nil>
+	// [1 3] strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExamplePercentile() {
+	latencies := []float64{12, 15, 11, 40, 13, 14, 95, 12, 16, 13}
+	median, _ := gofunc.Median(latencies)
+	p90, _ := gofunc.Percentile(latencies, 90, gofunc.QuantileLinear)
+	fmt.Printf("%.1f %.1f\n", median, p90)
+	// Output: 13.5 45.5
+}
+
+func ExampleRunningStats() {
+	// workers accumulate their own statistics, merged once they are done
+	var first, second gofunc.RunningStats[int]
+	first.Add(12, 15, 11)
+	second.Add(40, 13, 14)
+
+	first.Merge(&second)
+	mean, _ := first.Mean()
+	max, _ := first.Max()
+	fmt.Println(first.Count(), mean, max)
+	// Output: 6 17.5 40
+}
+
+func ExampleDecimal_Allocate() {
+	total := gofunc.MustParseDecimal("100.00")
+	shares, _ := total.Allocate(1, 1, 1)
+	fmt.Println(shares)
+	// Output: [33.34 33.33 33.33]
+}
+
+func ExampleMinBy() {
+	type Product struct {
+		Name  string
+		Price float64
+	}
+	products := []Product{{"Laptop", 999}, {"Mouse", 25}, {"Monitor", 300}}
+	cheapest, _ := gofunc.MinBy(products, func(p Product) float64 { return p.Price })
+	fmt.Println(cheapest.Name)
+	// Output: Mouse
+}
+
+func ExampleSample() {
+	// a seeded source picks the same winners on every run
+	r := rand.New(rand.NewSource(42))
+	winners, _ := gofunc.Sample([]string{"Ann", "Bob", "Cid", "Dee", "Eve"}, 2, r)
+	fmt.Println(winners)
+	// Output: [Ann Eve]
+}
+
+func ExampleDifference() {
+	subscribed := []string{"ann@example.com", "bob@example.com", "cid@example.com"}
+	unsubscribed := []string{"bob@example.com"}
+	fmt.Println(gofunc.Difference(subscribed, unsubscribed))
+	// Output: [ann@example.com cid@example.com]
+}
+
+func ExampleMostCommon() {
+	votes := []string{"go", "rust", "go", "zig", "rust", "go"}
+	for _, vc := range gofunc.MostCommon(votes, 2) {
+		fmt.Println(vc.Value, vc.Count)
+	}
+	// Output:
+	// go 3
+	// rust 2
+}
+
+func ExampleSafeSlice() {
+	items := []string{"a", "b", "c", "d", "e"}
+	pageSize := 2
+	for page := 0; page < 4; page++ {
+		fmt.Println(gofunc.SafeSlice(items, page*pageSize, (page+1)*pageSize))
+	}
+	// Output:
+	// [a b]
+	// [c d]
+	// [e]
+	// []
+}
+
+func ExampleEachCombination() {
+	teams := []string{"ants", "bees", "cats", "dogs"}
+	gofunc.EachCombination(teams, 2, func(match []string) bool {
+		fmt.Println(match[0], "vs", match[1])
+		return match[0] == "ants"
+	})
+	// Output:
+	// ants vs bees
+	// ants vs cats
+	// ants vs dogs
+	// bees vs cats
+}
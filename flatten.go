@@ -0,0 +1,60 @@
+package gofunc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Flatten concatenates the slices of a slice of slices into a new slice, removing one
+// level of nesting. It is ConcatSlices for slices that are already grouped.
+//
+// Example:
+//
+//	flat := gofunc.Flatten([][]int{{1, 2}, {}, {3}})
+//	// flat is []int{1, 2, 3}
+func Flatten[T any](s [][]T) []T {
+	return ConcatSlices(s...)
+}
+
+// FlattenDeep collects the T values found in arbitrarily nested slices and arrays, such as
+// [][][]T, or []interface{} mixing T values and slices of them, in depth-first order.
+// A value of type T is collected as is, even if T is itself a slice type, so use Flatten
+// and nested calls to it when the depth is known: they are faster and type-checked.
+// Returns an *ArgumentError if a value is neither a T nor a slice or array.
+//
+// Example:
+//
+//	flat, err := gofunc.FlattenDeep[int]([]interface{}{1, []int{2, 3}, [][]int{{4}}})
+//	// flat is []int{1, 2, 3, 4}, err is nil
+func FlattenDeep[T any](s interface{}) ([]T, error) {
+	result := make([]T, 0)
+	if err := flattenDeep(reflect.ValueOf(s), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func flattenDeep[T any](v reflect.Value, result *[]T) error {
+	if !v.IsValid() {
+		var zeroT T
+		return newArgumentError("s", fmt.Sprintf("nil is neither %T nor a slice", zeroT))
+	}
+	if t, ok := v.Interface().(T); ok {
+		*result = append(*result, t)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		return flattenDeep(v.Elem(), result)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := flattenDeep(v.Index(i), result); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		var zeroT T
+		return newArgumentError("s", fmt.Sprintf("%s is neither %T nor a slice", v.Type(), zeroT))
+	}
+}
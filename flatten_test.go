@@ -0,0 +1,46 @@
+package gofunc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Flatten(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, Flatten([][]int{{1, 2}, {}, {3}}))
+	assert.Equal(t, []int{}, Flatten([][]int{}))
+	assert.Equal(t, []int{}, Flatten[int](nil))
+
+	// the result does not share the backing array of the inner slices
+	inner := []int{1, 2}
+	flat := Flatten([][]int{inner})
+	flat[0] = -1
+	assert.Equal(t, []int{1, 2}, inner)
+}
+
+func Test_FlattenDeep(t *testing.T) {
+	flat, err := FlattenDeep[int]([][][]int{{{1, 2}, {3}}, {}, {{4}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, flat)
+
+	flat, err = FlattenDeep[int]([]interface{}{1, []int{2, 3}, [][]int{{4}}, [2]int{5, 6}, []interface{}{7}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, flat)
+
+	flat, err = FlattenDeep[int]([][]int{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, flat)
+
+	// values of type T are collected as is, even if T is a slice type
+	pairs, err := FlattenDeep[[]int]([][][]int{{{1, 2}}, {{3}}})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 2}, {3}}, pairs)
+
+	_, err = FlattenDeep[int]([]interface{}{1, "2"})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	_, err = FlattenDeep[int]([]interface{}{1, nil})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	_, err = FlattenDeep[int](nil)
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+}
@@ -0,0 +1,135 @@
+package gofunc
+
+import "sort"
+
+// ValueCount is a value with its number of occurrences, as returned by UniqCount and
+// MostCommon.
+type ValueCount[T any] struct {
+	Value T
+	Count int
+}
+
+// FindDuplicates returns the values that occur more than once in a slice, each once,
+// in their first occurrence order. The input slice is not modified.
+//
+// Example:
+//
+//	duplicates := gofunc.FindDuplicates([]string{"a", "b", "a", "c", "b", "a"})
+//	// duplicates is []string{"a", "b"}
+func FindDuplicates[T comparable](s []T) []T {
+	counts := Frequencies(s)
+	result := make([]T, 0)
+	for _, v := range s {
+		if counts[v] > 1 {
+			result = append(result, v)
+			// only report the value at its first occurrence
+			counts[v] = 0
+		}
+	}
+	return result
+}
+
+// FindDuplicatesBy groups the elements of a slice that share the same key, as extracted by
+// the key function, like ToSetPred does to drop them. Only the groups of more than one
+// element are returned, in the first occurrence order of their keys, and the elements of
+// each group keep their order.
+//
+// Example:
+//
+//	type User struct { ID int; Email string }
+//	conflicts := gofunc.FindDuplicatesBy(users, func(u User) string { return u.Email })
+//	// conflicts holds one []User per email used by several users
+func FindDuplicatesBy[T any, K comparable](s []T, keyFunc func(t T) K) [][]T {
+	var (
+		groups = make(map[K][]T, len(s))
+		keys   = make([]K, 0, len(s))
+	)
+
+	for _, v := range s {
+		k := keyFunc(v)
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], v)
+	}
+
+	result := make([][]T, 0)
+	for _, k := range keys {
+		if len(groups[k]) > 1 {
+			result = append(result, groups[k])
+		}
+	}
+	return result
+}
+
+// Frequencies counts the occurrences of each value of a slice.
+//
+// Example:
+//
+//	counts := gofunc.Frequencies([]string{"a", "b", "a"})
+//	// counts is map[string]int{"a": 2, "b": 1}
+func Frequencies[T comparable](s []T) map[T]int {
+	counts := make(map[T]int, len(s))
+	for _, v := range s {
+		counts[v]++
+	}
+	return counts
+}
+
+// UniqCount returns the distinct values of a slice with their number of occurrences,
+// in their first occurrence order. It is ToSet with counts: unlike the Unix uniq -c
+// command, occurrences do not need to be adjacent to be counted together.
+//
+// Example:
+//
+//	counts := gofunc.UniqCount([]string{"b", "a", "b"})
+//	// counts is []ValueCount[string]{{"b", 2}, {"a", 1}}
+func UniqCount[T comparable](s []T) []ValueCount[T] {
+	var (
+		index  = make(map[T]int, len(s))
+		result = make([]ValueCount[T], 0)
+	)
+
+	for _, v := range s {
+		if i, ok := index[v]; ok {
+			result[i].Count++
+			continue
+		}
+		index[v] = len(result)
+		result = append(result, ValueCount[T]{Value: v, Count: 1})
+	}
+	return result
+}
+
+// MostCommon returns the n most frequent values of a slice with their number of
+// occurrences, from the most frequent. Values with the same count keep their first
+// occurrence order. All the distinct values are returned if there are fewer than n, and
+// none if n is not positive.
+//
+// Example:
+//
+//	top := gofunc.MostCommon(strings.Fields(text), 3)
+//	// top holds the 3 most frequent words with their counts
+func MostCommon[T comparable](s []T, n int) []ValueCount[T] {
+	counts := UniqCount(s)
+	sort.SliceStable(counts, func(i, j int) bool { return counts[i].Count > counts[j].Count })
+	return counts[:Clamp(n, 0, len(counts))]
+}
+
+// IsUnique reports whether all the values of a slice are distinct.
+// It stops at the first duplicate.
+//
+// Example:
+//
+//	gofunc.IsUnique([]int{1, 2, 3}) // true
+//	gofunc.IsUnique([]int{1, 2, 1}) // false
+func IsUnique[T comparable](s []T) bool {
+	seen := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			return false
+		}
+		seen[v] = struct{}{}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package gofunc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Slice_FindDuplicates(t *testing.T) {
+	assert.Equal(t, []int{}, FindDuplicates([]int{}))
+	assert.Equal(t, []int{}, FindDuplicates([]int{1, 2, 3}))
+	assert.Equal(t, []string{"a", "b"}, FindDuplicates([]string{"a", "b", "a", "c", "b", "a"}))
+	assert.Equal(t, []int{3, 1}, FindDuplicates([]int{3, 1, 2, 1, 3}))
+
+	input := []int{1, 1}
+	FindDuplicates(input)
+	assert.Equal(t, []int{1, 1}, input)
+}
+
+func Test_Slice_FindDuplicatesBy(t *testing.T) {
+	items := []T{{X: 1, Y: "a"}, {X: 2, Y: "b"}, {X: 1, Y: "c"}, {X: 3, Y: "d"}, {X: 2, Y: "e"}, {X: 1, Y: "f"}}
+	assert.Equal(t, [][]T{
+		{{X: 1, Y: "a"}, {X: 1, Y: "c"}, {X: 1, Y: "f"}},
+		{{X: 2, Y: "b"}, {X: 2, Y: "e"}},
+	}, FindDuplicatesBy(items, func(item T) int64 { return item.X }))
+
+	assert.Equal(t, [][]T{}, FindDuplicatesBy(items, func(item T) string { return item.Y }))
+	assert.Equal(t, [][]int{}, FindDuplicatesBy([]int{}, func(i int) int { return i }))
+}
+
+func Test_Slice_Frequencies(t *testing.T) {
+	assert.Equal(t, map[int]int{}, Frequencies([]int{}))
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, Frequencies([]string{"a", "b", "a"}))
+}
+
+func Test_Slice_UniqCount(t *testing.T) {
+	assert.Equal(t, []ValueCount[int]{}, UniqCount([]int{}))
+	assert.Equal(t, []ValueCount[string]{{"b", 2}, {"a", 1}}, UniqCount([]string{"b", "a", "b"}))
+	assert.Equal(t, []ValueCount[int]{{1, 3}}, UniqCount([]int{1, 1, 1}))
+}
+
+func Test_Slice_MostCommon(t *testing.T) {
+	words := []string{"c", "a", "b", "a", "c", "d", "a"}
+	assert.Equal(t, []ValueCount[string]{{"a", 3}}, MostCommon(words, 1))
+	// b and d occur once each, and b comes first
+	assert.Equal(t, []ValueCount[string]{{"a", 3}, {"c", 2}, {"b", 1}}, MostCommon(words, 3))
+	assert.Equal(t, []ValueCount[string]{{"a", 3}, {"c", 2}, {"b", 1}, {"d", 1}}, MostCommon(words, 10))
+	assert.Equal(t, []ValueCount[string]{}, MostCommon(words, 0))
+	assert.Equal(t, []ValueCount[string]{}, MostCommon(words, -1))
+	assert.Equal(t, []ValueCount[int]{}, MostCommon([]int{}, 2))
+}
+
+func Test_Slice_IsUnique(t *testing.T) {
+	assert.True(t, IsUnique([]int{}))
+	assert.True(t, IsUnique([]int{1, 2, 3}))
+	assert.False(t, IsUnique([]int{1, 2, 1}))
+	assert.True(t, IsUnique([]string{"a", "A"}))
+}
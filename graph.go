@@ -0,0 +1,456 @@
+package gofunc
+
+import "container/heap"
+
+// Graph is a directed or undirected graph over comparable nodes, stored as adjacency lists.
+// Nodes and edges are deduplicated the same way ToSet deduplicates slice elements, and
+// every query returns nodes in the order they were first added, so results are deterministic.
+// The zero value is not usable; create a Graph with NewGraph or NewUndirectedGraph.
+//
+// Example:
+//
+//	g := gofunc.NewGraph[string]()
+//	g.AddEdge("db", "api")
+//	g.AddEdge("api", "web")
+//	order, err := g.TopologicalSort()
+//	// order is []string{"db", "api", "web"}, err is nil
+type Graph[N comparable] struct {
+	directed bool
+	nodes    []N
+	index    map[N]int
+	adj      [][]int
+	edges    map[[2]int]struct{}
+}
+
+// NewGraph creates an empty directed graph.
+func NewGraph[N comparable]() *Graph[N] {
+	return &Graph[N]{
+		directed: true,
+		index:    make(map[N]int),
+		edges:    make(map[[2]int]struct{}),
+	}
+}
+
+// NewUndirectedGraph creates an empty undirected graph, where every edge can be
+// traversed in both directions.
+func NewUndirectedGraph[N comparable]() *Graph[N] {
+	g := NewGraph[N]()
+	g.directed = false
+	return g
+}
+
+// Directed reports whether the graph is directed.
+func (g *Graph[N]) Directed() bool {
+	return g.directed
+}
+
+// Len returns the number of nodes in the graph.
+func (g *Graph[N]) Len() int {
+	return len(g.nodes)
+}
+
+// AddNode adds a node to the graph.
+// Returns true if the node was not present before.
+func (g *Graph[N]) AddNode(n N) bool {
+	if _, ok := g.index[n]; ok {
+		return false
+	}
+	g.addNode(n)
+	return true
+}
+
+func (g *Graph[N]) addNode(n N) int {
+	if i, ok := g.index[n]; ok {
+		return i
+	}
+	i := len(g.nodes)
+	g.index[n] = i
+	g.nodes = append(g.nodes, n)
+	g.adj = append(g.adj, nil)
+	return i
+}
+
+// AddEdge adds an edge between two nodes, adding the nodes first if needed.
+// In an undirected graph the edge can be traversed in both directions.
+// Returns true if the edge was not present before.
+func (g *Graph[N]) AddEdge(from, to N) bool {
+	i, j := g.addNode(from), g.addNode(to)
+	if _, ok := g.edges[[2]int{i, j}]; ok {
+		return false
+	}
+	g.edges[[2]int{i, j}] = struct{}{}
+	g.adj[i] = append(g.adj[i], j)
+	if !g.directed && i != j {
+		g.edges[[2]int{j, i}] = struct{}{}
+		g.adj[j] = append(g.adj[j], i)
+	}
+	return true
+}
+
+// HasNode reports whether the node is present in the graph.
+func (g *Graph[N]) HasNode(n N) bool {
+	_, ok := g.index[n]
+	return ok
+}
+
+// HasEdge reports whether an edge leads from one node to the other.
+func (g *Graph[N]) HasEdge(from, to N) bool {
+	i, ok := g.index[from]
+	if !ok {
+		return false
+	}
+	j, ok := g.index[to]
+	if !ok {
+		return false
+	}
+	_, ok = g.edges[[2]int{i, j}]
+	return ok
+}
+
+// Nodes returns all nodes in the order they were added.
+func (g *Graph[N]) Nodes() []N {
+	result := make([]N, len(g.nodes))
+	copy(result, g.nodes)
+	return result
+}
+
+// Neighbors returns the nodes reachable from n through a single edge, in the order
+// the edges were added. Returns an empty slice if the node is not in the graph.
+func (g *Graph[N]) Neighbors(n N) []N {
+	i, ok := g.index[n]
+	if !ok {
+		return []N{}
+	}
+	return g.toNodes(g.adj[i])
+}
+
+// TopologicalSort orders the nodes so that every edge points from an earlier node to a later one.
+// Returns a *CycleError describing one of the cycles if the graph is not acyclic.
+// In an undirected graph every edge forms a cycle, so only graphs without edges can be sorted.
+//
+// Example:
+//
+//	g := gofunc.NewGraph[string]()
+//	g.AddEdge("a", "b")
+//	g.AddEdge("b", "a")
+//	_, err := g.TopologicalSort()
+//	var cycleErr *gofunc.CycleError[string]
+//	if errors.As(err, &cycleErr) {
+//		// cycleErr.Cycle is []string{"a", "b", "a"}
+//	}
+func (g *Graph[N]) TopologicalSort() ([]N, error) {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	var (
+		state = make([]uint8, len(g.nodes))
+		path  = make([]int, 0, len(g.nodes))
+		order = make([]int, 0, len(g.nodes))
+		visit func(u int) []int
+	)
+	visit = func(u int) []int {
+		state[u] = onPath
+		path = append(path, u)
+		for _, v := range g.adj[u] {
+			switch state[v] {
+			case onPath:
+				// v is an ancestor of u, so the path from v to u closes a cycle
+				start := len(path) - 1
+				for path[start] != v {
+					start--
+				}
+				cycle := make([]int, 0, len(path)-start+1)
+				cycle = append(cycle, path[start:]...)
+				return append(cycle, v)
+			case unvisited:
+				if cycle := visit(v); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[u] = done
+		order = append(order, u)
+		return nil
+	}
+
+	for u := range g.nodes {
+		if state[u] != unvisited {
+			continue
+		}
+		if cycle := visit(u); cycle != nil {
+			return nil, &CycleError[N]{Cycle: g.toNodes(cycle)}
+		}
+	}
+
+	// nodes finish after all their descendants, so the post-order must be reversed
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	return g.toNodes(order), nil
+}
+
+// BFS visits every node reachable from start in breadth-first order, calling fn with
+// each node and its distance in edges from start. The traversal stops early if fn returns false.
+// Nothing is visited if start is not in the graph.
+func (g *Graph[N]) BFS(start N, fn func(node N, depth int) bool) {
+	s, ok := g.index[start]
+	if !ok {
+		return
+	}
+
+	depth := make([]int, len(g.nodes))
+	seen := make([]bool, len(g.nodes))
+	seen[s] = true
+	queue := []int{s}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		if !fn(g.nodes[u], depth[u]) {
+			return
+		}
+		for _, v := range g.adj[u] {
+			if !seen[v] {
+				seen[v] = true
+				depth[v] = depth[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+}
+
+// DFS visits every node reachable from start in depth-first pre-order, calling fn with each node.
+// The traversal stops early if fn returns false.
+// Nothing is visited if start is not in the graph.
+func (g *Graph[N]) DFS(start N, fn func(node N) bool) {
+	s, ok := g.index[start]
+	if !ok {
+		return
+	}
+
+	seen := make([]bool, len(g.nodes))
+	stack := []int{s}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+		if !fn(g.nodes[u]) {
+			return
+		}
+		// push in reverse so that neighbors are visited in insertion order
+		for i := len(g.adj[u]) - 1; i >= 0; i-- {
+			if v := g.adj[u][i]; !seen[v] {
+				stack = append(stack, v)
+			}
+		}
+	}
+}
+
+// ShortestPath finds the cheapest path between two nodes using Dijkstra's algorithm.
+// The weight function returns the cost of the edge between two adjacent nodes and must
+// never be negative. Returns the path including both ends and its total cost, or false
+// if to cannot be reached from from.
+//
+// Example:
+//
+//	latency := map[[2]string]int{{"a", "b"}: 5, {"b", "c"}: 1, {"a", "c"}: 10}
+//	path, cost, ok := gofunc.ShortestPath(g, "a", "c", func(from, to string) int {
+//		return latency[[2]string{from, to}]
+//	})
+//	// path is []string{"a", "b", "c"}, cost is 6, ok is true
+func ShortestPath[N comparable, W Number](g *Graph[N], from, to N, weight func(from, to N) W) ([]N, W, bool) {
+	var zeroW W
+	s, ok := g.index[from]
+	if !ok {
+		return nil, zeroW, false
+	}
+	t, ok := g.index[to]
+	if !ok {
+		return nil, zeroW, false
+	}
+
+	var (
+		dist    = make([]W, len(g.nodes))
+		reached = make([]bool, len(g.nodes))
+		settled = make([]bool, len(g.nodes))
+		prev    = make([]int, len(g.nodes))
+		queue   = &graphQueue[W]{}
+	)
+	reached[s] = true
+	prev[s] = -1
+	heap.Push(queue, graphQueueItem[W]{node: s})
+	for queue.Len() > 0 {
+		u := heap.Pop(queue).(graphQueueItem[W]).node
+		if settled[u] {
+			continue
+		}
+		settled[u] = true
+		if u == t {
+			break
+		}
+		for _, v := range g.adj[u] {
+			d := dist[u] + weight(g.nodes[u], g.nodes[v])
+			if !reached[v] || d < dist[v] {
+				reached[v] = true
+				dist[v] = d
+				prev[v] = u
+				heap.Push(queue, graphQueueItem[W]{node: v, dist: d})
+			}
+		}
+	}
+
+	if !reached[t] {
+		return nil, zeroW, false
+	}
+	path := []int{}
+	for u := t; u >= 0; u = prev[u] {
+		path = append(path, u)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return g.toNodes(path), dist[t], true
+}
+
+// ConnectedComponents groups nodes that are connected to each other, ignoring edge directions.
+// In a directed graph these are the weakly connected components.
+// Components are ordered by their first node, and nodes within a component keep insertion order.
+func (g *Graph[N]) ConnectedComponents() [][]N {
+	// undirected view of the adjacency lists
+	adj := g.adj
+	if g.directed {
+		adj = make([][]int, len(g.nodes))
+		for u := range g.adj {
+			for _, v := range g.adj[u] {
+				adj[u] = append(adj[u], v)
+				adj[v] = append(adj[v], u)
+			}
+		}
+	}
+
+	component := make([]int, len(g.nodes))
+	for i := range component {
+		component[i] = -1
+	}
+	count := 0
+	for s := range g.nodes {
+		if component[s] >= 0 {
+			continue
+		}
+		component[s] = count
+		stack := []int{s}
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, v := range adj[u] {
+				if component[v] < 0 {
+					component[v] = count
+					stack = append(stack, v)
+				}
+			}
+		}
+		count++
+	}
+	return g.groupComponents(component, count)
+}
+
+// StronglyConnectedComponents groups nodes that can all reach each other, using Tarjan's algorithm.
+// Components are ordered by their first node, and nodes within a component keep insertion order.
+func (g *Graph[N]) StronglyConnectedComponents() [][]N {
+	var (
+		index     = make([]int, len(g.nodes))
+		lowLink   = make([]int, len(g.nodes))
+		onStack   = make([]bool, len(g.nodes))
+		component = make([]int, len(g.nodes))
+		stack     = []int{}
+		next      = 1 // 0 marks unvisited nodes
+		count     = 0
+		connect   func(u int)
+	)
+	connect = func(u int) {
+		index[u], lowLink[u] = next, next
+		next++
+		stack = append(stack, u)
+		onStack[u] = true
+
+		for _, v := range g.adj[u] {
+			if index[v] == 0 {
+				connect(v)
+				lowLink[u] = minOf2(lowLink[u], lowLink[v])
+			} else if onStack[v] {
+				lowLink[u] = minOf2(lowLink[u], index[v])
+			}
+		}
+
+		// u is the root of a component: pop it off the stack
+		if lowLink[u] == index[u] {
+			for {
+				v := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[v] = false
+				component[v] = count
+				if v == u {
+					break
+				}
+			}
+			count++
+		}
+	}
+
+	for u := range g.nodes {
+		if index[u] == 0 {
+			connect(u)
+		}
+	}
+	return g.groupComponents(component, count)
+}
+
+// groupComponents turns a node-to-component mapping into groups of nodes,
+// renumbering components by their first node in insertion order.
+func (g *Graph[N]) groupComponents(component []int, count int) [][]N {
+	renumber := make([]int, count)
+	for i := range renumber {
+		renumber[i] = -1
+	}
+	result := make([][]N, 0, count)
+	for u, c := range component {
+		if renumber[c] < 0 {
+			renumber[c] = len(result)
+			result = append(result, []N{})
+		}
+		result[renumber[c]] = append(result[renumber[c]], g.nodes[u])
+	}
+	return result
+}
+
+func (g *Graph[N]) toNodes(indexes []int) []N {
+	result := make([]N, len(indexes))
+	for i, u := range indexes {
+		result[i] = g.nodes[u]
+	}
+	return result
+}
+
+type graphQueueItem[W Number] struct {
+	node int
+	dist W
+}
+
+// graphQueue is a min-heap of nodes ordered by their tentative distance.
+type graphQueue[W Number] []graphQueueItem[W]
+
+func (q graphQueue[W]) Len() int            { return len(q) }
+func (q graphQueue[W]) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q graphQueue[W]) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *graphQueue[W]) Push(x interface{}) { *q = append(*q, x.(graphQueueItem[W])) }
+func (q *graphQueue[W]) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
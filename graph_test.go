@@ -0,0 +1,228 @@
+package gofunc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Graph_AddNodesAndEdges(t *testing.T) {
+	g := NewGraph[string]()
+	assert.True(t, g.Directed())
+	assert.True(t, g.AddNode("a"))
+	assert.False(t, g.AddNode("a"))
+	assert.True(t, g.AddEdge("a", "b"))
+	assert.False(t, g.AddEdge("a", "b"))
+	assert.True(t, g.AddEdge("a", "c"))
+	assert.True(t, g.AddEdge("c", "c"))
+
+	assert.Equal(t, 3, g.Len())
+	assert.Equal(t, []string{"a", "b", "c"}, g.Nodes())
+	assert.Equal(t, []string{"b", "c"}, g.Neighbors("a"))
+	assert.Equal(t, []string{}, g.Neighbors("b"))
+	assert.Equal(t, []string{}, g.Neighbors("z"))
+	assert.True(t, g.HasNode("b"))
+	assert.False(t, g.HasNode("z"))
+	assert.True(t, g.HasEdge("a", "b"))
+	assert.False(t, g.HasEdge("b", "a"))
+	assert.False(t, g.HasEdge("z", "a"))
+	assert.False(t, g.HasEdge("a", "z"))
+
+	u := NewUndirectedGraph[int]()
+	assert.False(t, u.Directed())
+	assert.True(t, u.AddEdge(1, 2))
+	assert.False(t, u.AddEdge(2, 1))
+	assert.True(t, u.AddEdge(3, 3))
+	assert.True(t, u.HasEdge(2, 1))
+	assert.Equal(t, []int{1}, u.Neighbors(2))
+	assert.Equal(t, []int{3}, u.Neighbors(3))
+}
+
+func Test_Graph_TopologicalSort(t *testing.T) {
+	g := NewGraph[string]()
+	g.AddEdge("web", "cdn")
+	g.AddEdge("api", "web")
+	g.AddEdge("db", "api")
+	g.AddEdge("cache", "api")
+	g.AddNode("standalone")
+
+	order, e := g.TopologicalSort()
+	assert.NoError(t, e)
+	assert.Len(t, order, 6)
+	position := map[string]int{}
+	for i, n := range order {
+		position[n] = i
+	}
+	for _, n := range g.Nodes() {
+		for _, m := range g.Neighbors(n) {
+			assert.Less(t, position[n], position[m], "%s must come before %s", n, m)
+		}
+	}
+
+	empty, e := NewGraph[int]().TopologicalSort()
+	assert.NoError(t, e)
+	assert.Equal(t, []int{}, empty)
+}
+
+func Test_Graph_TopologicalSort_Cycle(t *testing.T) {
+	g := NewGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 2)
+
+	order, e := g.TopologicalSort()
+	assert.Nil(t, order)
+	assert.ErrorIs(t, e, ErrCycle)
+
+	var cycleErr *CycleError[int]
+	assert.True(t, errors.As(e, &cycleErr))
+	assert.Equal(t, []int{2, 3, 4, 2}, cycleErr.Cycle)
+	assert.Equal(t, "graph contains a cycle: [2 3 4 2]", e.Error())
+
+	self := NewGraph[string]()
+	self.AddEdge("a", "a")
+	_, e = self.TopologicalSort()
+	var selfErr *CycleError[string]
+	assert.True(t, errors.As(e, &selfErr))
+	assert.Equal(t, []string{"a", "a"}, selfErr.Cycle)
+}
+
+func Test_Graph_BFS(t *testing.T) {
+	g := NewGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(6, 1)
+
+	nodes, depths := []int{}, []int{}
+	g.BFS(1, func(n int, depth int) bool {
+		nodes = append(nodes, n)
+		depths = append(depths, depth)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, nodes)
+	assert.Equal(t, []int{0, 1, 1, 2, 3}, depths)
+
+	nodes = []int{}
+	g.BFS(1, func(n int, depth int) bool {
+		nodes = append(nodes, n)
+		return n != 3
+	})
+	assert.Equal(t, []int{1, 2, 3}, nodes)
+
+	nodes = []int{}
+	g.BFS(42, func(n int, depth int) bool {
+		nodes = append(nodes, n)
+		return true
+	})
+	assert.Equal(t, []int{}, nodes)
+}
+
+func Test_Graph_DFS(t *testing.T) {
+	g := NewGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 1)
+
+	nodes := []int{}
+	g.DFS(1, func(n int) bool {
+		nodes = append(nodes, n)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 4, 3}, nodes)
+
+	nodes = []int{}
+	g.DFS(1, func(n int) bool {
+		nodes = append(nodes, n)
+		return len(nodes) < 2
+	})
+	assert.Equal(t, []int{1, 2}, nodes)
+
+	nodes = []int{}
+	g.DFS(42, func(n int) bool {
+		nodes = append(nodes, n)
+		return true
+	})
+	assert.Equal(t, []int{}, nodes)
+}
+
+func Test_Graph_ShortestPath(t *testing.T) {
+	g := NewGraph[string]()
+	weights := map[[2]string]float64{
+		{"a", "b"}: 4,
+		{"a", "c"}: 1,
+		{"c", "b"}: 2,
+		{"b", "d"}: 1,
+		{"c", "d"}: 5,
+	}
+	for e := range weights {
+		g.AddEdge(e[0], e[1])
+	}
+	g.AddNode("island")
+	weight := func(from, to string) float64 { return weights[[2]string{from, to}] }
+
+	path, cost, ok := ShortestPath(g, "a", "d", weight)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "c", "b", "d"}, path)
+	assert.Equal(t, 4.0, cost)
+
+	path, cost, ok = ShortestPath(g, "a", "a", weight)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a"}, path)
+	assert.Equal(t, 0.0, cost)
+
+	_, _, ok = ShortestPath(g, "d", "a", weight)
+	assert.False(t, ok)
+	_, _, ok = ShortestPath(g, "a", "island", weight)
+	assert.False(t, ok)
+	_, _, ok = ShortestPath(g, "a", "unknown", weight)
+	assert.False(t, ok)
+	_, _, ok = ShortestPath(g, "unknown", "a", weight)
+	assert.False(t, ok)
+
+	// Unit weights give the path with the fewest edges
+	hops, n, ok := ShortestPath(g, "a", "d", func(string, string) uint { return 1 })
+	assert.True(t, ok)
+	assert.Equal(t, uint(2), n)
+	assert.Len(t, hops, 3)
+}
+
+func Test_Graph_ConnectedComponents(t *testing.T) {
+	g := NewGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 2)
+	g.AddEdge(4, 5)
+	g.AddNode(6)
+	g.AddEdge(5, 7)
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 7}, {6}}, g.ConnectedComponents())
+
+	u := NewUndirectedGraph[string]()
+	u.AddEdge("a", "b")
+	u.AddEdge("c", "d")
+	u.AddEdge("b", "e")
+	assert.Equal(t, [][]string{{"a", "b", "e"}, {"c", "d"}}, u.ConnectedComponents())
+
+	assert.Equal(t, [][]int{}, NewGraph[int]().ConnectedComponents())
+}
+
+func Test_Graph_StronglyConnectedComponents(t *testing.T) {
+	g := NewGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 4)
+	g.AddEdge(6, 7)
+	g.AddNode(8)
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}, {7}, {8}}, g.StronglyConnectedComponents())
+
+	assert.Equal(t, [][]int{}, NewGraph[int]().StronglyConnectedComponents())
+}
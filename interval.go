@@ -0,0 +1,247 @@
+package gofunc
+
+import (
+	"sort"
+)
+
+// Interval represents the closed numeric range [Start, End].
+// Both bounds belong to the interval, so intervals sharing an endpoint overlap.
+// An interval is well-formed when Start <= End; use NewInterval to build one from
+// bounds given in any order.
+//
+// Example:
+//
+//	window := gofunc.Interval[int64]{Start: 1700000000, End: 1700003600}
+//	// window.Contains(1700001800) is true
+type Interval[T Number] struct {
+	Start T
+	End   T
+}
+
+// NewInterval creates an interval between a and b, swapping the bounds if needed
+// so that Start <= End.
+//
+// Example:
+//
+//	iv := gofunc.NewInterval(10, 2)
+//	// iv is Interval[int]{Start: 2, End: 10}
+func NewInterval[T Number](a, b T) Interval[T] {
+	if b < a {
+		a, b = b, a
+	}
+	return Interval[T]{Start: a, End: b}
+}
+
+// Len returns the length of the interval, End - Start.
+func (iv Interval[T]) Len() T {
+	return iv.End - iv.Start
+}
+
+// Contains reports whether the value lies within the interval, bounds included.
+func (iv Interval[T]) Contains(v T) bool {
+	return iv.Start <= v && v <= iv.End
+}
+
+// Overlaps reports whether the two intervals share at least one value.
+func (iv Interval[T]) Overlaps(other Interval[T]) bool {
+	return iv.Start <= other.End && other.Start <= iv.End
+}
+
+// Intersect returns the interval of values shared by both intervals.
+// Returns false if the intervals do not overlap.
+//
+// Example:
+//
+//	a := gofunc.Interval[int]{Start: 1, End: 5}
+//	b := gofunc.Interval[int]{Start: 3, End: 8}
+//	iv, ok := a.Intersect(b)
+//	// iv is {3, 5}, ok is true
+func (iv Interval[T]) Intersect(other Interval[T]) (Interval[T], bool) {
+	if !iv.Overlaps(other) {
+		return Interval[T]{}, false
+	}
+	return Interval[T]{Start: maxOf2(iv.Start, other.Start), End: minOf2(iv.End, other.End)}, true
+}
+
+// Union returns the smallest interval covering both intervals.
+// Returns false if the intervals do not overlap, since their union would not be a single interval.
+//
+// Example:
+//
+//	a := gofunc.Interval[int]{Start: 1, End: 5}
+//	b := gofunc.Interval[int]{Start: 3, End: 8}
+//	iv, ok := a.Union(b)
+//	// iv is {1, 8}, ok is true
+func (iv Interval[T]) Union(other Interval[T]) (Interval[T], bool) {
+	if !iv.Overlaps(other) {
+		return Interval[T]{}, false
+	}
+	return Interval[T]{Start: minOf2(iv.Start, other.Start), End: maxOf2(iv.End, other.End)}, true
+}
+
+// MergeIntervals merges overlapping intervals and returns the result sorted by Start.
+// The input slice is not modified.
+//
+// Example:
+//
+//	intervals := []gofunc.Interval[int]{{1, 3}, {8, 10}, {2, 6}}
+//	merged := gofunc.MergeIntervals(intervals)
+//	// merged is []Interval[int]{{1, 6}, {8, 10}}
+func MergeIntervals[T Number](intervals []Interval[T]) []Interval[T] {
+	sorted := make([]Interval[T], len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	result := make([]Interval[T], 0, len(sorted))
+	for _, iv := range sorted {
+		last := len(result) - 1
+		if last >= 0 && iv.Start <= result[last].End {
+			result[last].End = maxOf2(result[last].End, iv.End)
+			continue
+		}
+		result = append(result, iv)
+	}
+	return result
+}
+
+// IntervalTree answers stabbing and overlap queries over a fixed set of intervals
+// in O(log n + k) time, where k is the number of matching intervals.
+// It is built once from a slice; build a new tree when the intervals change.
+//
+// Example:
+//
+//	tree := gofunc.NewIntervalTree([]gofunc.Interval[int]{{1, 5}, {4, 9}, {10, 12}})
+//	hits := tree.Stab(4)
+//	// hits is []Interval[int]{{1, 5}, {4, 9}}
+type IntervalTree[T Number] struct {
+	// intervals sorted by Start form an implicit balanced search tree:
+	// the middle element of each range is the root of that range.
+	intervals []Interval[T]
+	// maxEnd[i] is the largest End in the subtree rooted at i.
+	maxEnd []T
+}
+
+// NewIntervalTree builds an IntervalTree from the given intervals.
+// The input slice is not modified.
+func NewIntervalTree[T Number](intervals []Interval[T]) *IntervalTree[T] {
+	sorted := make([]Interval[T], len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	t := &IntervalTree[T]{intervals: sorted, maxEnd: make([]T, len(sorted))}
+	if len(sorted) > 0 {
+		t.build(0, len(sorted))
+	}
+	return t
+}
+
+func (t *IntervalTree[T]) build(lo, hi int) T {
+	mid := int(uint(lo+hi) >> 1)
+	max := t.intervals[mid].End
+	if lo < mid {
+		max = maxOf2(max, t.build(lo, mid))
+	}
+	if mid+1 < hi {
+		max = maxOf2(max, t.build(mid+1, hi))
+	}
+	t.maxEnd[mid] = max
+	return max
+}
+
+// Len returns the number of intervals in the tree.
+func (t *IntervalTree[T]) Len() int {
+	return len(t.intervals)
+}
+
+// Stab returns all intervals containing the given value, sorted by Start.
+func (t *IntervalTree[T]) Stab(v T) []Interval[T] {
+	return t.Overlapping(Interval[T]{Start: v, End: v})
+}
+
+// Overlapping returns all intervals overlapping the given interval, sorted by Start.
+func (t *IntervalTree[T]) Overlapping(query Interval[T]) []Interval[T] {
+	result := []Interval[T]{}
+	t.query(0, len(t.intervals), query, &result)
+	return result
+}
+
+func (t *IntervalTree[T]) query(lo, hi int, query Interval[T], result *[]Interval[T]) {
+	if lo >= hi {
+		return
+	}
+	mid := int(uint(lo+hi) >> 1)
+	// nothing in this subtree reaches the query
+	if t.maxEnd[mid] < query.Start {
+		return
+	}
+	t.query(lo, mid, query, result)
+	// intervals to the right start even later, so stop once past the query
+	if t.intervals[mid].Start > query.End {
+		return
+	}
+	if t.intervals[mid].Overlaps(query) {
+		*result = append(*result, t.intervals[mid])
+	}
+	t.query(mid+1, hi, query, result)
+}
+
+// Range generates the values from start up to, but not including, end, spaced by step.
+// A negative step counts down from start to end. The result can be passed directly
+// to ChunkSlice, Sort or any other slice helper.
+// Panics with an *ArgumentError if step is zero; use TryRange to get an error instead.
+//
+// Example:
+//
+//	values := gofunc.Range(0, 10, 3)
+//	// values is []int{0, 3, 6, 9}
+//	countdown := gofunc.Range(5, 0, -2)
+//	// countdown is []int{5, 3, 1}
+func Range[T Number](start, end, step T) []T {
+	return Must(TryRange(start, end, step))
+}
+
+// TryRange generates evenly spaced values, like Range.
+// Returns an *ArgumentError if step is zero, or if start, end or step is infinite or NaN.
+// For floating-point types, it also returns an *ArgumentError if step is too small for the
+// precision of T around the values of the range, which would repeat values.
+func TryRange[T Number](start, end, step T) ([]T, error) {
+	if step == 0 {
+		return nil, newArgumentError("step", "must not be zero")
+	}
+	if !isFinite(start) {
+		return nil, newArgumentError("start", "must be finite")
+	}
+	if !isFinite(end) {
+		return nil, newArgumentError("end", "must be finite")
+	}
+	if !isFinite(step) {
+		return nil, newArgumentError("step", "must be finite")
+	}
+
+	before := func(v T) bool {
+		return (step > 0 && v < end) || (step < 0 && v > end)
+	}
+	result := make([]T, 0)
+	if isFloat[T]() {
+		// computing each value from start avoids accumulating floating-point error
+		for i := 0; before(start + T(i)*step); i++ {
+			v := start + T(i)*step
+			// rounding is monotonic, so a lost step shows as a repeated value
+			if i > 0 && v == result[i-1] {
+				return nil, newArgumentError("step", "too small for the precision of the values")
+			}
+			result = append(result, v)
+		}
+		return result, nil
+	}
+	for v := start; before(v); {
+		result = append(result, v)
+		next := v + step
+		// a value that overflows T is past end
+		if (step > 0 && next < v) || (step < 0 && next > v) {
+			break
+		}
+		v = next
+	}
+	return result, nil
+}
@@ -0,0 +1,165 @@
+package gofunc
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Interval_Basics(t *testing.T) {
+	iv := NewInterval(10, 2)
+	assert.Equal(t, Interval[int]{Start: 2, End: 10}, iv)
+	assert.Equal(t, 8, iv.Len())
+	assert.True(t, iv.Contains(2))
+	assert.True(t, iv.Contains(10))
+	assert.False(t, iv.Contains(11))
+	assert.False(t, iv.Contains(1))
+
+	f := Interval[float64]{Start: 0.5, End: 1.5}
+	assert.True(t, f.Contains(1.0))
+	assert.Equal(t, 1.0, f.Len())
+}
+
+func Test_Interval_Overlaps(t *testing.T) {
+	a := Interval[int]{1, 5}
+	assert.True(t, a.Overlaps(Interval[int]{3, 8}))
+	assert.True(t, a.Overlaps(Interval[int]{5, 8}))
+	assert.True(t, a.Overlaps(Interval[int]{2, 3}))
+	assert.True(t, a.Overlaps(Interval[int]{0, 10}))
+	assert.False(t, a.Overlaps(Interval[int]{6, 8}))
+	assert.False(t, a.Overlaps(Interval[int]{-3, 0}))
+}
+
+func Test_Interval_IntersectUnion(t *testing.T) {
+	a := Interval[int]{1, 5}
+	b := Interval[int]{3, 8}
+
+	iv, ok := a.Intersect(b)
+	assert.True(t, ok)
+	assert.Equal(t, Interval[int]{3, 5}, iv)
+	iv, ok = a.Union(b)
+	assert.True(t, ok)
+	assert.Equal(t, Interval[int]{1, 8}, iv)
+
+	iv, ok = a.Intersect(Interval[int]{5, 9})
+	assert.True(t, ok)
+	assert.Equal(t, Interval[int]{5, 5}, iv)
+
+	_, ok = a.Intersect(Interval[int]{6, 9})
+	assert.False(t, ok)
+	_, ok = a.Union(Interval[int]{6, 9})
+	assert.False(t, ok)
+}
+
+func Test_Interval_MergeIntervals(t *testing.T) {
+	assert.Equal(t, []Interval[int]{}, MergeIntervals([]Interval[int]{}))
+	assert.Equal(t, []Interval[int]{{1, 2}}, MergeIntervals([]Interval[int]{{1, 2}}))
+
+	input := []Interval[int]{{8, 10}, {1, 3}, {2, 6}, {15, 18}, {6, 7}, {17, 20}}
+	assert.Equal(t, []Interval[int]{{1, 7}, {8, 10}, {15, 20}}, MergeIntervals(input))
+	// Input is not modified
+	assert.Equal(t, Interval[int]{8, 10}, input[0])
+
+	assert.Equal(t, []Interval[float64]{{0, 2.5}}, MergeIntervals([]Interval[float64]{{0, 1}, {0.5, 2.5}, {1, 2}}))
+}
+
+func Test_IntervalTree(t *testing.T) {
+	empty := NewIntervalTree([]Interval[int]{})
+	assert.Equal(t, 0, empty.Len())
+	assert.Equal(t, []Interval[int]{}, empty.Stab(1))
+
+	tree := NewIntervalTree([]Interval[int]{{10, 12}, {1, 5}, {4, 9}, {3, 3}, {0, 20}})
+	assert.Equal(t, 5, tree.Len())
+	assert.Equal(t, []Interval[int]{{0, 20}, {1, 5}, {4, 9}}, tree.Stab(4))
+	assert.Equal(t, []Interval[int]{{0, 20}, {1, 5}, {3, 3}}, tree.Stab(3))
+	assert.Equal(t, []Interval[int]{{0, 20}, {10, 12}}, tree.Stab(12))
+	assert.Equal(t, []Interval[int]{}, tree.Stab(21))
+	assert.Equal(t, []Interval[int]{{0, 20}, {4, 9}, {10, 12}}, tree.Overlapping(Interval[int]{6, 10}))
+}
+
+func Test_IntervalTree_RandomizedAgainstScan(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	intervals := make([]Interval[int], 300)
+	for i := range intervals {
+		intervals[i] = NewInterval(r.Intn(1000), r.Intn(1000))
+	}
+	tree := NewIntervalTree(intervals)
+
+	for i := 0; i < 200; i++ {
+		query := NewInterval(r.Intn(1000), r.Intn(1000))
+		expected := 0
+		for _, iv := range intervals {
+			if iv.Overlaps(query) {
+				expected++
+			}
+		}
+		found := tree.Overlapping(query)
+		assert.Len(t, found, expected)
+		for _, iv := range found {
+			assert.True(t, iv.Overlaps(query))
+		}
+	}
+}
+
+func Test_Range(t *testing.T) {
+	assert.Equal(t, []int{0, 3, 6, 9}, Range(0, 10, 3))
+	assert.Equal(t, []int{0, 1, 2}, Range(0, 3, 1))
+	assert.Equal(t, []int{5, 3, 1}, Range(5, 0, -2))
+	assert.Equal(t, []int{}, Range(0, 0, 1))
+	assert.Equal(t, []int{}, Range(5, 0, 1))
+	assert.Equal(t, []int{}, Range(0, 5, -1))
+	assert.Equal(t, []uint8{250, 252, 254}, Range[uint8](250, 255, 2))
+	assert.Equal(t, []int8{-128, -28, 72}, Range[int8](-128, 127, 100))
+	assert.Equal(t, []float64{0, 0.25, 0.5, 0.75}, Range(0, 1, 0.25))
+	assert.Len(t, Range(0, 1, 0.1), 10)
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, ChunkSlice(Range(1, 6, 1), 2))
+
+	t.Run("panics on zero step", func(t *testing.T) {
+		assert.Panics(t, func() { Range(0, 10, 0) })
+	})
+	t.Run("TryRange", func(t *testing.T) {
+		values, e := TryRange(0, 10, 0)
+		assert.Nil(t, values)
+		assert.ErrorIs(t, e, ErrInvalidArgument)
+		values, e = TryRange(0, 3, 1)
+		assert.NoError(t, e)
+		assert.Equal(t, []int{0, 1, 2}, values)
+	})
+	t.Run("non-finite bounds", func(t *testing.T) {
+		for _, args := range [][3]float64{
+			{0, math.NaN(), 1},
+			{0, math.Inf(1), 1},
+			{math.Inf(-1), 0, 1},
+			{0, 1, math.Inf(1)},
+			{0, 1, math.NaN()},
+		} {
+			values, e := TryRange(args[0], args[1], args[2])
+			assert.Nil(t, values)
+			assert.ErrorIs(t, e, ErrInvalidArgument)
+		}
+		assert.Panics(t, func() { Range(0, math.Inf(1), 1) })
+		// end - start overflows float64, but the values do not
+		assert.Equal(t, []float64{-1e308, 0}, Range(-1e308, 1e308, 1e308))
+	})
+	t.Run("steps lost to float precision", func(t *testing.T) {
+		values, e := TryRange[float32](1<<24, 1<<24+4, 1)
+		assert.Nil(t, values)
+		assert.ErrorIs(t, e, ErrInvalidArgument)
+		// the precision runs out in the middle of the range
+		_, e = TryRange[float32](1<<24-2, 1<<24+2, 1)
+		assert.ErrorIs(t, e, ErrInvalidArgument)
+		_, e = TryRange(1e16, 1e16+10, 1.0)
+		assert.ErrorIs(t, e, ErrInvalidArgument)
+		assert.Equal(t, []float32{1 << 24, 1<<24 + 2}, Range[float32](1<<24, 1<<24+4, 2))
+	})
+	t.Run("large integer ranges", func(t *testing.T) {
+		assert.Equal(t, []int64{0, 1 << 61, 1 << 62}, Range[int64](0, 1<<62+1, 1<<61))
+		// stepping past the last value would overflow int64
+		assert.Equal(t, []int64{0, 1 << 62}, Range[int64](0, math.MaxInt64, 1<<62))
+		assert.Equal(t, []int64{math.MinInt64 + 1, 0}, Range[int64](math.MinInt64+1, math.MaxInt64, math.MaxInt64))
+		assert.Equal(t, []uint64{math.MaxUint64 - 1}, Range[uint64](math.MaxUint64-1, math.MaxUint64, 2))
+		assert.Equal(t, []int64{math.MinInt64 + 10, math.MinInt64 + 3}, Range[int64](math.MinInt64+10, math.MinInt64, -7))
+	})
+}
@@ -1,21 +1,342 @@
 package gofunc
 
-import "math"
+import "fmt"
 
-// Abs returns the absolute value of an int64.
-// Panics if x is math.MinInt64 since it cannot be represented as a positive int64.
+// Abs returns the absolute value of a signed integer or a float.
+// Panics if x is the lowest value of a signed integer type, such as math.MinInt64,
+// since it has no positive counterpart; use TryAbs to get an error instead.
 //
 // Example:
 //
 //	result := gofunc.Abs(-42)
 //	// result is 42
-func Abs(x int64) int64 {
+func Abs[T Int | Float](x T) T {
+	return Must(TryAbs(x))
+}
+
+// TryAbs returns the absolute value of a signed integer or a float, like Abs.
+// Returns an error matching ErrOverflow if x is the lowest value of its integer type.
+//
+// Example:
+//
+//	_, err := gofunc.TryAbs(int64(math.MinInt64))
+//	// errors.Is(err, gofunc.ErrOverflow) is true
+func TryAbs[T Int | Float](x T) (T, error) {
 	if x < 0 {
-		// The lowest value of int64 has no corresponding positive value
-		if x == math.MinInt64 {
-			panic("unable to calculate abs of the lowest int64 value")
+		// The lowest value of a signed integer type has no corresponding positive value
+		if -x < 0 {
+			return 0, fmt.Errorf("unable to calculate abs of the lowest %T value: %w", x, ErrOverflow)
+		}
+		return -x, nil
+	}
+	return x, nil
+}
+
+// Sign returns -1 if x is negative, 1 if x is positive and 0 if x is zero or NaN.
+//
+// Example:
+//
+//	gofunc.Sign(-3.5) // -1
+func Sign[T Number](x T) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Clamp limits x to the closed range [lo, hi].
+// Panics with an *ArgumentError if lo is greater than hi.
+//
+// Example:
+//
+//	volume := gofunc.Clamp(requested, 0, 100)
+//	// volume is requested, unless it is below 0 or above 100
+func Clamp[T Number | ~string](x, lo, hi T) T {
+	if lo > hi {
+		panic(newArgumentError("lo", "must not be greater than hi"))
+	}
+	return minOf2(maxOf2(x, lo), hi)
+}
+
+// AddChecked returns a+b, or an error matching ErrOverflow if the sum does not fit in T.
+//
+// Example:
+//
+//	_, err := gofunc.AddChecked[int8](100, 100)
+//	// errors.Is(err, gofunc.ErrOverflow) is true
+func AddChecked[T Int | UInt](a, b T) (T, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, overflowError(a, "+", b)
+	}
+	return c, nil
+}
+
+// SubChecked returns a-b, or an error matching ErrOverflow if the difference does not fit in T.
+//
+// Example:
+//
+//	_, err := gofunc.SubChecked[uint](1, 2)
+//	// errors.Is(err, gofunc.ErrOverflow) is true
+func SubChecked[T Int | UInt](a, b T) (T, error) {
+	c := a - b
+	if (b > 0 && c > a) || (b < 0 && c < a) {
+		return 0, overflowError(a, "-", b)
+	}
+	return c, nil
+}
+
+// MulChecked returns a*b, or an error matching ErrOverflow if the product does not fit in T.
+//
+// Example:
+//
+//	_, err := gofunc.MulChecked[int32](1<<16, 1<<16)
+//	// errors.Is(err, gofunc.ErrOverflow) is true
+func MulChecked[T Int | UInt](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	// the division check misses the lowest signed value times -1, which wraps to itself
+	if c/b != a || (isSigned[T]() && b == ^T(0) && a == minOfInt[T]()) {
+		return 0, overflowError(a, "*", b)
+	}
+	return c, nil
+}
+
+// Pow returns base raised to the power exp, or an error matching ErrOverflow if the
+// result does not fit in T. Returns an *ArgumentError if exp is negative.
+//
+// Example:
+//
+//	n, err := gofunc.Pow(2, 10)
+//	// n is 1024, err is nil
+func Pow[T Int | UInt](base T, exp int) (T, error) {
+	if exp < 0 {
+		return 0, newArgumentError("exp", "must not be negative")
+	}
+	origExp := exp
+	// exponentiation by squaring, checking every multiplication that is actually used
+	result, square := T(1), base
+	for {
+		var err error
+		if exp&1 == 1 {
+			if result, err = MulChecked(result, square); err != nil {
+				break
+			}
+		}
+		exp >>= 1
+		if exp == 0 {
+			return result, nil
+		}
+		if square, err = MulChecked(square, square); err != nil {
+			break
+		}
+	}
+	return 0, fmt.Errorf("%v to the power of %d overflows %T: %w", base, origExp, base, ErrOverflow)
+}
+
+// AddSaturating returns a+b, or the highest or lowest value of T if the sum overflows.
+//
+// Example:
+//
+//	gofunc.AddSaturating[uint8](200, 100) // 255
+func AddSaturating[T Int | UInt](a, b T) T {
+	c, err := AddChecked(a, b)
+	if err != nil {
+		return If(b > 0, maxOfInt[T](), minOfInt[T]())
+	}
+	return c
+}
+
+// SubSaturating returns a-b, or the highest or lowest value of T if the difference overflows.
+//
+// Example:
+//
+//	gofunc.SubSaturating[uint](1, 2) // 0
+func SubSaturating[T Int | UInt](a, b T) T {
+	c, err := SubChecked(a, b)
+	if err != nil {
+		return If(b > 0, minOfInt[T](), maxOfInt[T]())
+	}
+	return c
+}
+
+// MulSaturating returns a*b, or the highest or lowest value of T if the product overflows.
+//
+// Example:
+//
+//	gofunc.MulSaturating[int8](-100, 2) // -128
+func MulSaturating[T Int | UInt](a, b T) T {
+	c, err := MulChecked(a, b)
+	if err != nil {
+		return If((a < 0) != (b < 0), minOfInt[T](), maxOfInt[T]())
+	}
+	return c
+}
+
+// GCD returns the greatest common divisor of a and b, which is never negative.
+// GCD(0, 0) is 0.
+// Panics with an error matching ErrOverflow if the result does not fit in T, which only
+// happens when it is the absolute value of the lowest value of a signed T, as for
+// GCD(math.MinInt64, 0); use GCDChecked to get an error instead.
+//
+// Example:
+//
+//	gofunc.GCD(12, -18) // 6
+func GCD[T Int | UInt](a, b T) T {
+	return Must(GCDChecked(a, b))
+}
+
+// GCDChecked returns the greatest common divisor of a and b, like GCD, or an error
+// matching ErrOverflow if it does not fit in T.
+//
+// Example:
+//
+//	_, err := gofunc.GCDChecked[int8](-128, 0)
+//	// errors.Is(err, gofunc.ErrOverflow) is true
+func GCDChecked[T Int | UInt](a, b T) (T, error) {
+	g, r := a, b
+	for r != 0 {
+		g, r = r, g%r
+	}
+	if g < 0 {
+		// the lowest value of a signed integer type has no corresponding positive value
+		if -g < 0 {
+			return 0, fmt.Errorf("gcd of %v and %v overflows %T: %w", a, b, a, ErrOverflow)
 		}
-		return -x
+		return -g, nil
 	}
-	return x
+	return g, nil
+}
+
+// LCM returns the least common multiple of a and b, which is never negative,
+// or an error matching ErrOverflow if it does not fit in T, including when its absolute
+// value is the minimum of a signed T, as for LCM(math.MinInt64, 1). LCM with 0 is 0.
+//
+// Example:
+//
+//	n, err := gofunc.LCM(4, 6)
+//	// n is 12, err is nil
+func LCM[T Int | UInt](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	overflow := func() error {
+		return fmt.Errorf("lcm of %v and %v overflows %T: %w", a, b, a, ErrOverflow)
+	}
+	g, err := GCDChecked(a, b)
+	if err != nil {
+		return 0, overflow()
+	}
+	l, err := MulChecked(a/g, b)
+	if err != nil {
+		return 0, overflow()
+	}
+	if l < 0 {
+		if l = -l; l < 0 {
+			return 0, overflow()
+		}
+	}
+	return l, nil
+}
+
+// DivFloor returns a/b rounded towards negative infinity, whereas the / operator
+// rounds towards zero. Panics if b is zero, like the / operator.
+//
+// Example:
+//
+//	gofunc.DivFloor(-7, 2) // -4, where -7/2 is -3
+func DivFloor[T Int | UInt](a, b T) T {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// DivCeil returns a/b rounded towards positive infinity. Panics if b is zero.
+// It is handy to compute a number of pages or batches.
+//
+// Example:
+//
+//	pages := gofunc.DivCeil(totalItems, pageSize)
+//	// DivCeil(10, 3) is 4
+func DivCeil[T Int | UInt](a, b T) T {
+	q := a / b
+	if a%b != 0 && (a < 0) == (b < 0) {
+		q++
+	}
+	return q
+}
+
+// ToNumberSliceChecked converts a slice of numbers to another numeric type, like
+// ToNumberSlice, but reports conversions that lose information instead of silently
+// truncating: values out of range of U, negative values converted to an unsigned type,
+// fractions converted to an integer type, and values that lose precision, such as large
+// integers converted to a float.
+// Returns an error matching ErrLossyConversion that names the first offending value.
+//
+// Example:
+//
+//	_, err := gofunc.ToNumberSliceChecked[uint8]([]int{1, 300})
+//	// errors.Is(err, gofunc.ErrLossyConversion) is true
+func ToNumberSliceChecked[U, T Number](slice []T) ([]U, error) {
+	result := make([]U, len(slice))
+	for i, v := range slice {
+		u := U(v)
+		// NaN never equals itself, but converting it between floats is not lossy
+		isNaN := v != v && u != u
+		if !isNaN && (T(u) != v || (v < 0) != (u < 0)) {
+			return nil, fmt.Errorf("value %v at index %d cannot be represented as %T: %w", v, i, u, ErrLossyConversion)
+		}
+		result[i] = u
+	}
+	return result, nil
+}
+
+func overflowError[T Int | UInt](a T, op string, b T) error {
+	return fmt.Errorf("%v %s %v overflows %T: %w", a, op, b, a, ErrOverflow)
+}
+
+func isSigned[T Int | UInt]() bool {
+	var zero T
+	return zero-1 < zero
+}
+
+func minOfInt[T Int | UInt]() T {
+	if !isSigned[T]() {
+		return 0
+	}
+	// shift a bit up to the sign bit
+	x := T(1)
+	for x<<1 > 0 {
+		x <<= 1
+	}
+	return x << 1
+}
+
+func maxOfInt[T Int | UInt]() T {
+	if !isSigned[T]() {
+		return ^T(0)
+	}
+	return minOfInt[T]() - 1
+}
+
+// isFloat reports whether T is a floating-point type.
+func isFloat[T Number]() bool {
+	// integer division truncates 1/2 to 0
+	one := T(1)
+	return one/2 != 0
+}
+
+// isFinite reports whether x is neither infinite nor NaN, which is always the case for
+// integers.
+func isFinite[T Number](x T) bool {
+	// x-x is NaN, and so not 0, for infinities and NaN
+	return x-x == 0
 }
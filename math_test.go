@@ -8,9 +8,17 @@ import (
 )
 
 func Test_Abs(t *testing.T) {
-	assert.Equal(t, int64(1), Abs(1))
-	assert.Equal(t, int64(10), Abs(-10))
-	assert.Equal(t, int64(0), Abs(0))
+	assert.Equal(t, int64(1), Abs(int64(1)))
+	assert.Equal(t, int64(10), Abs(int64(-10)))
+	assert.Equal(t, int64(0), Abs(int64(0)))
+	assert.Equal(t, 10, Abs(-10))
+	assert.Equal(t, int8(127), Abs(int8(-127)))
+	assert.Equal(t, 2.5, Abs(-2.5))
+	assert.Equal(t, float32(0), Abs(float32(0)))
+	assert.True(t, math.IsInf(Abs(math.Inf(-1)), 1))
+
+	type Delta int16
+	assert.Equal(t, Delta(5), Abs(Delta(-5)))
 
 	// Error case
 	t.Run("panics", func(t *testing.T) {
@@ -22,6 +30,231 @@ func Test_Abs(t *testing.T) {
 			}
 		}()
 
-		Abs(math.MinInt64)
+		Abs(int64(math.MinInt64))
 	})
 }
+
+func Test_TryAbs(t *testing.T) {
+	v, e := TryAbs(int64(-42))
+	assert.NoError(t, e)
+	assert.Equal(t, int64(42), v)
+
+	_, e = TryAbs(int64(math.MinInt64))
+	assert.ErrorIs(t, e, ErrOverflow)
+	assert.EqualError(t, e, "unable to calculate abs of the lowest int64 value: overflow")
+	_, e = TryAbs(int8(math.MinInt8))
+	assert.ErrorIs(t, e, ErrOverflow)
+
+	// Abs panics with the same error
+	_, e = Try(func() int64 { return Abs(int64(math.MinInt64)) })
+	assert.ErrorIs(t, e, ErrOverflow)
+}
+
+func Test_Sign(t *testing.T) {
+	assert.Equal(t, -1, Sign(-3))
+	assert.Equal(t, 0, Sign(0))
+	assert.Equal(t, 1, Sign(uint(7)))
+	assert.Equal(t, -1, Sign(-0.5))
+	assert.Equal(t, 0, Sign(math.NaN()))
+}
+
+func Test_Clamp(t *testing.T) {
+	assert.Equal(t, 5, Clamp(5, 0, 10))
+	assert.Equal(t, 0, Clamp(-5, 0, 10))
+	assert.Equal(t, 10, Clamp(15, 0, 10))
+	assert.Equal(t, 1.5, Clamp(1.5, 1.5, 1.5))
+	assert.Equal(t, "m", Clamp("z", "a", "m"))
+	assert.Panics(t, func() { Clamp(1, 10, 0) })
+}
+
+func Test_CheckedArithmetic(t *testing.T) {
+	v8, err := AddChecked[int8](100, 27)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(127), v8)
+	_, err = AddChecked[int8](100, 28)
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.EqualError(t, err, "100 + 28 overflows int8: overflow")
+	_, err = AddChecked[int8](-100, -29)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = AddChecked[uint64](math.MaxUint64, 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	v8, err = SubChecked[int8](-100, 28)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-128), v8)
+	_, err = SubChecked[int8](-100, 29)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = SubChecked[int8](0, -128)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = SubChecked[uint](1, 2)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	v32, err := MulChecked[int32](-1<<15, 1<<16)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(math.MinInt32), v32)
+	_, err = MulChecked[int32](1<<16, 1<<15)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = MulChecked[int64](math.MinInt64, -1)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = MulChecked[int64](-1, math.MinInt64)
+	assert.ErrorIs(t, err, ErrOverflow)
+	vu, err := MulChecked[uint8](15, 17)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(255), vu)
+	_, err = MulChecked[uint8](16, 16)
+	assert.ErrorIs(t, err, ErrOverflow)
+	v, err := MulChecked(0, math.MinInt)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, v)
+}
+
+func Test_Pow(t *testing.T) {
+	v, err := Pow(2, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1024, v)
+	v, err = Pow(7, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	v, err = Pow(-3, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, -27, v)
+
+	v8, err := Pow[int8](-2, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-128), v8)
+	_, err = Pow[int8](2, 7)
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.EqualError(t, err, "2 to the power of 7 overflows int8: overflow")
+
+	vu, err := Pow[uint64](10, 19)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1e19), vu)
+	_, err = Pow[uint64](10, 20)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	v, err = Pow(1, math.MaxInt)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	_, err = Pow(2, -1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func Test_SaturatingArithmetic(t *testing.T) {
+	assert.Equal(t, uint8(255), AddSaturating[uint8](200, 100))
+	assert.Equal(t, uint8(201), AddSaturating[uint8](200, 1))
+	assert.Equal(t, int8(-128), AddSaturating[int8](-100, -100))
+	assert.Equal(t, int8(127), AddSaturating[int8](100, 100))
+
+	assert.Equal(t, uint(0), SubSaturating[uint](1, 2))
+	assert.Equal(t, int16(math.MaxInt16), SubSaturating[int16](1, math.MinInt16))
+	assert.Equal(t, int16(math.MinInt16), SubSaturating[int16](-2, math.MaxInt16))
+
+	assert.Equal(t, int8(-128), MulSaturating[int8](-100, 2))
+	assert.Equal(t, int8(127), MulSaturating[int8](-100, -2))
+	assert.Equal(t, int64(math.MaxInt64), MulSaturating[int64](math.MinInt64, -1))
+	assert.Equal(t, uint32(math.MaxUint32), MulSaturating[uint32](1<<16, 1<<16))
+	assert.Equal(t, 6, MulSaturating(2, 3))
+}
+
+func Test_GCDLCM(t *testing.T) {
+	assert.Equal(t, 6, GCD(12, 18))
+	assert.Equal(t, 6, GCD(12, -18))
+	assert.Equal(t, 6, GCD(-12, -18))
+	assert.Equal(t, 5, GCD(0, -5))
+	assert.Equal(t, 0, GCD(0, 0))
+	assert.Equal(t, uint(1), GCD[uint](17, 5))
+
+	v, err := LCM(4, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, v)
+	v, err = LCM(-4, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, v)
+	v, err = LCM(0, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, v)
+	_, err = LCM[int8](16, 9)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = LCM[int8](-128, 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = LCM[int8](-128, -128)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = LCM(math.MinInt64, 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	// the absolute value of the minimum does not fit in a signed type
+	assert.PanicsWithError(t, "gcd of -9223372036854775808 and 0 overflows int: overflow", func() {
+		GCD(math.MinInt64, 0)
+	})
+	assert.Panics(t, func() { GCD[int8](-128, -128) })
+	assert.Equal(t, int8(64), GCD[int8](-128, 64))
+	_, err = GCDChecked(math.MinInt64, 0)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = GCDChecked[int8](-128, -128)
+	assert.ErrorIs(t, err, ErrOverflow)
+	g, err := GCDChecked(math.MinInt64, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, g)
+	ug, err := GCDChecked[uint8](0, 255)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(255), ug)
+}
+
+func Test_DivFloorCeil(t *testing.T) {
+	tests := []struct {
+		a, b, floor, ceil int
+	}{
+		{7, 2, 3, 4},
+		{-7, 2, -4, -3},
+		{7, -2, -4, -3},
+		{-7, -2, 3, 4},
+		{6, 3, 2, 2},
+		{-6, 3, -2, -2},
+		{0, 5, 0, 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.floor, DivFloor(tt.a, tt.b), "DivFloor(%d, %d)", tt.a, tt.b)
+		assert.Equal(t, tt.ceil, DivCeil(tt.a, tt.b), "DivCeil(%d, %d)", tt.a, tt.b)
+	}
+	assert.Equal(t, uint(4), DivCeil[uint](10, 3))
+	assert.Equal(t, uint(3), DivFloor[uint](10, 3))
+	assert.Panics(t, func() { DivCeil(1, 0) })
+}
+
+func Test_ToNumberSliceChecked(t *testing.T) {
+	u8, err := ToNumberSliceChecked[uint8]([]int{0, 1, 255})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{0, 1, 255}, u8)
+
+	_, err = ToNumberSliceChecked[uint8]([]int{1, 300})
+	assert.ErrorIs(t, err, ErrLossyConversion)
+	assert.EqualError(t, err, "value 300 at index 1 cannot be represented as uint8: numeric conversion loses information")
+
+	_, err = ToNumberSliceChecked[uint16]([]int8{-1})
+	assert.ErrorIs(t, err, ErrLossyConversion)
+	_, err = ToNumberSliceChecked[int8]([]uint8{200})
+	assert.ErrorIs(t, err, ErrLossyConversion)
+	_, err = ToNumberSliceChecked[int]([]float64{1, 2.5})
+	assert.ErrorIs(t, err, ErrLossyConversion)
+	_, err = ToNumberSliceChecked[float64]([]int64{1<<53 + 1})
+	assert.ErrorIs(t, err, ErrLossyConversion)
+	_, err = ToNumberSliceChecked[int64]([]float64{1e20})
+	assert.ErrorIs(t, err, ErrLossyConversion)
+
+	ints, err := ToNumberSliceChecked[int]([]float64{1, -2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, -2, 3}, ints)
+
+	floats, err := ToNumberSliceChecked[float32]([]float64{0.5, math.NaN(), math.Inf(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0.5), floats[0])
+	assert.True(t, floats[1] != floats[1])
+	_, err = ToNumberSliceChecked[int]([]float64{math.NaN()})
+	assert.ErrorIs(t, err, ErrLossyConversion)
+
+	type Score uint16
+	scores, err := ToNumberSliceChecked[Score]([]int{}) // empty input
+	assert.NoError(t, err)
+	assert.Equal(t, []Score{}, scores)
+}
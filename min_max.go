@@ -2,13 +2,76 @@ package gofunc
 
 // Min finds the minimum value among the provided arguments.
 // Returns an error if no arguments are provided.
-// Works with numeric types and strings.
+// Works with numeric types and strings. MinOf takes a slice instead.
 //
 // Example:
 //
 //	min, err := gofunc.Min(3, 1, 4, 1, 5)
 //	// min is 1, err is nil
 func Min[T Number | ~string](s ...T) (T, error) {
+	return MinOf(s)
+}
+
+// Max finds the maximum value among the provided arguments.
+// Returns an error if no arguments are provided.
+// Works with numeric types and strings. MaxOf takes a slice instead.
+//
+// Example:
+//
+//	max, err := gofunc.Max(3, 1, 4, 1, 5)
+//	// max is 5, err is nil
+func Max[T Number | ~string](s ...T) (T, error) {
+	return MaxOf(s)
+}
+
+// MinFunc finds the minimum value of a slice using a comparator, which returns a negative
+// number if a is less than b, zero if they are equal and a positive number if a is greater.
+// The first of several minimums is returned. Returns an error if the slice is empty.
+//
+// Example:
+//
+//	cheapest, err := gofunc.MinFunc(prices, gofunc.Decimal.Cmp)
+func MinFunc[T any](s []T, cmp func(a, b T) int) (T, error) {
+	if len(s) == 0 {
+		var zeroT T
+		return zeroT, ErrInputRequired
+	}
+	min := s[0]
+	for i := range s {
+		if cmp(s[i], min) < 0 {
+			min = s[i]
+		}
+	}
+	return min, nil
+}
+
+// MaxFunc finds the maximum value of a slice using a comparator, like MinFunc.
+// The first of several maximums is returned. Returns an error if the slice is empty.
+//
+// Example:
+//
+//	latest, err := gofunc.MaxFunc(timestamps, time.Time.Compare)
+func MaxFunc[T any](s []T, cmp func(a, b T) int) (T, error) {
+	if len(s) == 0 {
+		var zeroT T
+		return zeroT, ErrInputRequired
+	}
+	max := s[0]
+	for i := range s {
+		if cmp(s[i], max) > 0 {
+			max = s[i]
+		}
+	}
+	return max, nil
+}
+
+// MinOf finds the minimum value of a slice, like Min.
+// Returns an error if the slice is empty.
+//
+// Example:
+//
+//	min, err := gofunc.MinOf(scores)
+func MinOf[T Number | ~string](s []T) (T, error) {
 	if len(s) == 0 {
 		var zeroT T
 		return zeroT, ErrInputRequired
@@ -22,15 +85,13 @@ func Min[T Number | ~string](s ...T) (T, error) {
 	return min, nil
 }
 
-// Max finds the maximum value among the provided arguments.
-// Returns an error if no arguments are provided.
-// Works with numeric types and strings.
+// MaxOf finds the maximum value of a slice, like Max.
+// Returns an error if the slice is empty.
 //
 // Example:
 //
-//	max, err := gofunc.Max(3, 1, 4, 1, 5)
-//	// max is 5, err is nil
-func Max[T Number | ~string](s ...T) (T, error) {
+//	max, err := gofunc.MaxOf(scores)
+func MaxOf[T Number | ~string](s []T) (T, error) {
 	if len(s) == 0 {
 		var zeroT T
 		return zeroT, ErrInputRequired
@@ -43,3 +104,129 @@ func Max[T Number | ~string](s ...T) (T, error) {
 	}
 	return max, nil
 }
+
+// MinMax finds both the minimum and the maximum value of a slice in a single pass.
+// Returns an error if the slice is empty.
+//
+// Example:
+//
+//	lo, hi, err := gofunc.MinMax([]int{3, 1, 4, 1, 5})
+//	// lo is 1, hi is 5, err is nil
+func MinMax[T Number | ~string](s []T) (min T, max T, err error) {
+	if len(s) == 0 {
+		return min, max, ErrInputRequired
+	}
+	min, max = s[0], s[0]
+	for i := range s {
+		if s[i] < min {
+			min = s[i]
+		} else if s[i] > max {
+			max = s[i]
+		}
+	}
+	return min, max, nil
+}
+
+// MinMaxFunc finds both the minimum and the maximum value of a slice in a single pass using
+// a comparator, like MinFunc and MaxFunc. The first of several minimums or maximums is
+// returned. Returns an error if the slice is empty.
+//
+// Example:
+//
+//	first, last, err := gofunc.MinMaxFunc(timestamps, time.Time.Compare)
+func MinMaxFunc[T any](s []T, cmp func(a, b T) int) (min T, max T, err error) {
+	if len(s) == 0 {
+		return min, max, ErrInputRequired
+	}
+	min, max = s[0], s[0]
+	for i := range s {
+		if cmp(s[i], min) < 0 {
+			min = s[i]
+		} else if cmp(s[i], max) > 0 {
+			max = s[i]
+		}
+	}
+	return min, max, nil
+}
+
+// MinBy finds the element of a slice with the smallest key, as extracted by the key
+// function. The key function is called once per element, and the first of several
+// elements with the smallest key is returned. Returns an error if the slice is empty.
+//
+// Example:
+//
+//	type Person struct { Name string; Age int }
+//	youngest, err := gofunc.MinBy(people, func(p Person) int { return p.Age })
+func MinBy[T any, K Number | ~string](s []T, keyFunc func(t T) K) (T, error) {
+	i, err := argBy(s, keyFunc, func(a, b K) bool { return a < b })
+	if err != nil {
+		var zeroT T
+		return zeroT, err
+	}
+	return s[i], nil
+}
+
+// MaxBy finds the element of a slice with the largest key, like MinBy.
+// Returns an error if the slice is empty.
+//
+// Example:
+//
+//	oldest, err := gofunc.MaxBy(people, func(p Person) int { return p.Age })
+func MaxBy[T any, K Number | ~string](s []T, keyFunc func(t T) K) (T, error) {
+	i, err := argBy(s, keyFunc, func(a, b K) bool { return a > b })
+	if err != nil {
+		var zeroT T
+		return zeroT, err
+	}
+	return s[i], nil
+}
+
+// ArgMin returns the index of the minimum value of a slice, or of the first one if there
+// are several. Returns -1 and an error if the slice is empty.
+//
+// Example:
+//
+//	i, err := gofunc.ArgMin([]int{3, 1, 4, 1, 5})
+//	// i is 1, err is nil
+func ArgMin[T Number | ~string](s []T) (int, error) {
+	return argBy(s, func(t T) T { return t }, func(a, b T) bool { return a < b })
+}
+
+// ArgMax returns the index of the maximum value of a slice, or of the first one if there
+// are several. Returns -1 and an error if the slice is empty.
+//
+// Example:
+//
+//	i, err := gofunc.ArgMax([]int{3, 1, 4, 1, 5})
+//	// i is 4, err is nil
+func ArgMax[T Number | ~string](s []T) (int, error) {
+	return argBy(s, func(t T) T { return t }, func(a, b T) bool { return a > b })
+}
+
+// argBy returns the index of the first element whose key is better than all the others'.
+func argBy[T any, K Number | ~string](s []T, keyFunc func(t T) K, better func(a, b K) bool) (int, error) {
+	if len(s) == 0 {
+		return -1, ErrInputRequired
+	}
+	best, bestKey := 0, keyFunc(s[0])
+	for i := 1; i < len(s); i++ {
+		if k := keyFunc(s[i]); better(k, bestKey) {
+			best, bestKey = i, k
+		}
+	}
+	return best, nil
+}
+
+func minOf2[T Number | ~string](a, b T) T {
+	if b < a {
+		return b
+	}
+	return a
+}
+
+func maxOf2[T Number | ~string](a, b T) T {
+	if b > a {
+		return b
+	}
+	return a
+}
@@ -27,3 +27,95 @@ func Test_Max(t *testing.T) {
 	_, e := Max[string]()
 	assert.ErrorIs(t, e, ErrInputRequired)
 }
+
+func Test_MinMaxFunc(t *testing.T) {
+	byX := func(a, b T) int { return compareOrdered(a.X, b.X) }
+	items := []T{{X: 3, Y: "a"}, {X: -1, Y: "b"}, {X: 7, Y: "c"}, {X: -1, Y: "d"}, {X: 7, Y: "e"}}
+
+	// the first of equal values wins
+	assert.Equal(t, T{X: -1, Y: "b"}, Must(MinFunc(items, byX)))
+	assert.Equal(t, T{X: 7, Y: "c"}, Must(MaxFunc(items, byX)))
+	assert.Equal(t, "10", Must(MaxFunc([]string{"1", "01", "10"}, compareOrdered[string])))
+
+	// Error case
+	_, e := MinFunc([]T{}, byX)
+	assert.ErrorIs(t, e, ErrInputRequired)
+	_, e = MaxFunc(nil, byX)
+	assert.ErrorIs(t, e, ErrInputRequired)
+}
+
+func Test_MinOfMaxOf(t *testing.T) {
+	assert.Equal(t, -10, Must(MinOf([]int{0, 2, -10, -5, 3, 5})))
+	assert.Equal(t, 30, Must(MaxOf([]int{0, 2, -10, -5, 30, 5, 30})))
+	assert.Equal(t, "01", Must(MinOf([]string{"1", "01", "10"})))
+
+	// Error case
+	_, e := MinOf([]int{})
+	assert.ErrorIs(t, e, ErrInputRequired)
+	_, e = MaxOf[float64](nil)
+	assert.ErrorIs(t, e, ErrInputRequired)
+}
+
+func Test_MinMax(t *testing.T) {
+	lo, hi, err := MinMax([]int{3, 1, 4, 1, 5})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, lo)
+	assert.Equal(t, 5, hi)
+	lo, hi, err = MinMax([]int{7})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, lo)
+	assert.Equal(t, 7, hi)
+	loS, hiS, err := MinMax([]string{"b", "c", "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", loS)
+	assert.Equal(t, "c", hiS)
+
+	byX := func(a, b T) int { return compareOrdered(a.X, b.X) }
+	items := []T{{X: 3, Y: "a"}, {X: -1, Y: "b"}, {X: 7, Y: "c"}, {X: -1, Y: "d"}, {X: 7, Y: "e"}}
+	first, last, err := MinMaxFunc(items, byX)
+	assert.NoError(t, err)
+	assert.Equal(t, T{X: -1, Y: "b"}, first)
+	assert.Equal(t, T{X: 7, Y: "c"}, last)
+
+	// Error case
+	_, _, err = MinMax([]float64{})
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, _, err = MinMaxFunc(nil, byX)
+	assert.ErrorIs(t, err, ErrInputRequired)
+}
+
+func Test_MinByMaxBy(t *testing.T) {
+	items := []T{{X: 3, Y: "a"}, {X: -1, Y: "b"}, {X: 7, Y: "c"}, {X: -1, Y: "d"}, {X: 7, Y: "e"}}
+	calls := 0
+	byX := func(item T) int64 {
+		calls++
+		return item.X
+	}
+
+	// the first of equal keys wins, and each key is computed once
+	assert.Equal(t, T{X: -1, Y: "b"}, Must(MinBy(items, byX)))
+	assert.Equal(t, len(items), calls)
+	assert.Equal(t, T{X: 7, Y: "c"}, Must(MaxBy(items, byX)))
+	assert.Equal(t, T{X: 7, Y: "e"}, Must(MaxBy(items, func(item T) string { return item.Y })))
+
+	// Error case
+	_, e := MinBy([]T{}, byX)
+	assert.ErrorIs(t, e, ErrInputRequired)
+	_, e = MaxBy(nil, byX)
+	assert.ErrorIs(t, e, ErrInputRequired)
+}
+
+func Test_ArgMinArgMax(t *testing.T) {
+	assert.Equal(t, 1, Must(ArgMin([]int{3, 1, 4, 1, 5})))
+	assert.Equal(t, 4, Must(ArgMax([]int{3, 1, 4, 1, 5})))
+	assert.Equal(t, 0, Must(ArgMax([]float64{9.5, 1, 9.5})))
+	assert.Equal(t, 2, Must(ArgMin([]string{"b", "c", "a"})))
+
+	// Error case
+	i, e := ArgMin([]int{})
+	assert.ErrorIs(t, e, ErrInputRequired)
+	assert.Equal(t, -1, i)
+	i, e = ArgMax([]string{})
+	assert.ErrorIs(t, e, ErrInputRequired)
+	assert.Equal(t, -1, i)
+}
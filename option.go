@@ -0,0 +1,99 @@
+package gofunc
+
+// Option holds either a value (Some) or nothing (None).
+// It is the value-type equivalent of Go's (T, bool) idiom, which makes it easy to pass
+// optional values around or store them in structs and slices.
+// The zero value is None.
+//
+// Example:
+//
+//	opt := gofunc.OptionOf(gofunc.FindPred(users, isAdmin))
+//	admin := opt.OrElse(defaultAdmin)
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some creates an Option holding the given value.
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+// None creates an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionOf creates an Option from the (T, bool) idiom used by FindPred, map lookups
+// and type assertions. The Option is Some if ok is true, None otherwise.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4}
+//	even := gofunc.OptionOf(gofunc.FindPred(numbers, func(n int) bool { return n%2 == 0 }))
+//	// even is Some(2)
+func OptionOf[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// IsSome reports whether the Option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether the Option is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true, or the zero value and false if the Option is None.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// OrElse returns the value, or defaultVal if the Option is None.
+func (o Option[T]) OrElse(defaultVal T) T {
+	if !o.ok {
+		return defaultVal
+	}
+	return o.value
+}
+
+// OkOr converts the Option into a Result, using err when the Option is None.
+//
+// Example:
+//
+//	admin, err := gofunc.OptionOf(gofunc.FindPred(users, isAdmin)).OkOr(ErrNoAdmin).Get()
+func (o Option[T]) OkOr(err error) Result[T] {
+	if !o.ok {
+		return Err[T](err)
+	}
+	return Ok(o.value)
+}
+
+// OptionMap applies fn to the value of the Option, if any.
+// Returns None if the Option is None, without calling fn.
+//
+// Example:
+//
+//	name := gofunc.Some("alice")
+//	length := gofunc.OptionMap(name, func(s string) int { return len(s) })
+//	// length is Some(5)
+func OptionMap[T, U any](o Option[T], fn func(t T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(fn(o.value))
+}
+
+// OptionAndThen applies fn, which itself returns an Option, to the value of the Option.
+// Returns None if the Option is None, without calling fn.
+func OptionAndThen[T, U any](o Option[T], fn func(t T) Option[U]) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return fn(o.value)
+}
@@ -0,0 +1,64 @@
+package gofunc
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Option_SomeNone(t *testing.T) {
+	some := Some(42)
+	assert.True(t, some.IsSome())
+	assert.False(t, some.IsNone())
+	v, ok := some.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 42, v)
+	assert.Equal(t, 42, some.OrElse(0))
+
+	none := None[int]()
+	assert.False(t, none.IsSome())
+	assert.True(t, none.IsNone())
+	v, ok = none.Get()
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+	assert.Equal(t, -1, none.OrElse(-1))
+
+	var zero Option[string]
+	assert.True(t, zero.IsNone())
+}
+
+func Test_Option_OptionOf(t *testing.T) {
+	numbers := []int{1, 3, 4, 5}
+	even := OptionOf(FindPred(numbers, func(n int) bool { return n%2 == 0 }))
+	assert.Equal(t, Some(4), even)
+
+	big := OptionOf(FindPred(numbers, func(n int) bool { return n > 10 }))
+	assert.Equal(t, None[int](), big)
+
+	m := map[string]int{"a": 1}
+	v, ok := m["a"]
+	assert.Equal(t, Some(1), OptionOf(v, ok))
+}
+
+func Test_Option_OkOr(t *testing.T) {
+	errMissing := errors.New("missing")
+	assert.Equal(t, Ok("x"), Some("x").OkOr(errMissing))
+	r := None[string]().OkOr(errMissing)
+	assert.ErrorIs(t, r.Err(), errMissing)
+}
+
+func Test_Option_MapAndThen(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	assert.Equal(t, Some(5), OptionMap(Some("alice"), length))
+	assert.Equal(t, None[int](), OptionMap(None[string](), length))
+
+	parse := func(s string) Option[int] {
+		n, err := strconv.Atoi(s)
+		return OptionOf(n, err == nil)
+	}
+	assert.Equal(t, Some(42), OptionAndThen(Some("42"), parse))
+	assert.Equal(t, None[int](), OptionAndThen(Some("x"), parse))
+	assert.Equal(t, None[int](), OptionAndThen(None[string](), parse))
+}
@@ -0,0 +1,311 @@
+package gofunc
+
+import (
+	"context"
+	"math"
+	"math/rand"
+)
+
+// Shuffle shuffles a slice in place with the Fisher-Yates algorithm and returns it.
+// The random source makes the result reproducible: the same seed gives the same order.
+// A nil source uses the global source of math/rand.
+//
+// Example:
+//
+//	r := rand.New(rand.NewSource(42))
+//	deck := gofunc.Shuffle(cards, r)
+func Shuffle[T any](s []T, r *rand.Rand) []T {
+	randOrDefault(r).Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+	return s
+}
+
+// Sample returns k elements picked at random from a slice, without replacement: each
+// element is picked at most once. The order of the result is random too, and the input
+// slice is not modified. A nil source uses the global source of math/rand.
+// Returns an *ArgumentError if k is negative or greater than the length of the slice.
+//
+// Example:
+//
+//	r := rand.New(rand.NewSource(42))
+//	winners, err := gofunc.Sample(participants, 3, r)
+func Sample[T any](s []T, k int, r *rand.Rand) ([]T, error) {
+	if k < 0 || k > len(s) {
+		return nil, newArgumentError("k", "must be between 0 and the length of the slice")
+	}
+	r = randOrDefault(r)
+	// a partial Fisher-Yates shuffle of the indexes, only recording the swapped ones so
+	// that small samples of large slices stay cheap
+	swapped := make(map[int]int, k)
+	result := make([]T, k)
+	for i := 0; i < k; i++ {
+		j := i + r.Intn(len(s)-i)
+		picked, ok := swapped[j]
+		if !ok {
+			picked = j
+		}
+		if moved, ok := swapped[i]; ok {
+			swapped[j] = moved
+		} else {
+			swapped[j] = i
+		}
+		result[i] = s[picked]
+	}
+	return result, nil
+}
+
+// Choice returns an element picked at random from a slice.
+// A nil source uses the global source of math/rand.
+// Returns ErrInputRequired if the slice is empty.
+//
+// Example:
+//
+//	r := rand.New(rand.NewSource(42))
+//	server, err := gofunc.Choice(servers, r)
+func Choice[T any](s []T, r *rand.Rand) (T, error) {
+	if len(s) == 0 {
+		var zeroT T
+		return zeroT, ErrInputRequired
+	}
+	return s[randOrDefault(r).Intn(len(s))], nil
+}
+
+// WeightedChoice returns an element picked at random from a slice, with a probability
+// proportional to its weight, with the alias method of WeightedSampler. Building the alias
+// table takes linear time, so use NewWeightedSampler to pick many elements from the same
+// weights in constant time each.
+// A nil source uses the global source of math/rand.
+// Returns ErrInputRequired if the slice is empty, or an *ArgumentError if the weights do
+// not match the items, are negative or not finite, or are all zero.
+//
+// Example:
+//
+//	r := rand.New(rand.NewSource(42))
+//	variant, err := gofunc.WeightedChoice([]string{"A", "B"}, []float64{90, 10}, r)
+//	// variant is "A" 90% of the time
+func WeightedChoice[T any](items []T, weights []float64, r *rand.Rand) (T, error) {
+	total, err := checkWeights(items, weights)
+	if err != nil {
+		var zeroT T
+		return zeroT, err
+	}
+	return items[newAliasTable(weights, total).pick(r)], nil
+}
+
+// WeightedSampler picks elements at random with probabilities proportional to their
+// weights, in constant time per pick thanks to Vose's alias method. It is safe for
+// concurrent use if each goroutine passes its own source.
+//
+// Example:
+//
+//	sampler, err := gofunc.NewWeightedSampler([]string{"A", "B", "C"}, []float64{50, 30, 20})
+//	r := rand.New(rand.NewSource(42))
+//	for i := 0; i < requests; i++ {
+//		route(sampler.Sample(r))
+//	}
+type WeightedSampler[T any] struct {
+	items []T
+	table aliasTable
+}
+
+// NewWeightedSampler creates a WeightedSampler for the items and their weights, in
+// linear time. Returns ErrInputRequired if there are no items, or an *ArgumentError if the
+// weights do not match the items, are negative or not finite, or are all zero.
+func NewWeightedSampler[T any](items []T, weights []float64) (*WeightedSampler[T], error) {
+	total, err := checkWeights(items, weights)
+	if err != nil {
+		return nil, err
+	}
+	return &WeightedSampler[T]{items: append([]T(nil), items...), table: newAliasTable(weights, total)}, nil
+}
+
+// Sample returns an element picked at random. A nil source uses the global source of
+// math/rand.
+func (s *WeightedSampler[T]) Sample(r *rand.Rand) T {
+	return s.items[s.table.pick(r)]
+}
+
+// aliasTable picks indexes with probabilities proportional to weights, in constant time,
+// with Vose's alias method: each of the n columns holds one index with a probability and
+// an alias index for the rest.
+type aliasTable struct {
+	prob  []float64 // probability of keeping the column's own index
+	alias []int     // index of the column otherwise
+}
+
+// newAliasTable builds the table for weights checked by checkWeights, which sum to total.
+func newAliasTable(weights []float64, total float64) aliasTable {
+	// scale the weights so that they average 1, then fill every column up to 1 by pairing
+	// an index below the average with the remainder of an index above it
+	n := len(weights)
+	t := aliasTable{prob: make([]float64, n), alias: make([]int, n)}
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		l, g := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		t.prob[l], t.alias[l] = scaled[l], g
+		scaled[g] -= 1 - scaled[l]
+		if scaled[g] < 1 {
+			large, small = large[:len(large)-1], append(small, g)
+		}
+	}
+	// what is left is 1 up to rounding errors
+	for _, i := range append(small, large...) {
+		t.prob[i], t.alias[i] = 1, i
+	}
+	return t
+}
+
+func (t aliasTable) pick(r *rand.Rand) int {
+	r = randOrDefault(r)
+	i := r.Intn(len(t.prob))
+	if r.Float64() < t.prob[i] {
+		return i
+	}
+	return t.alias[i]
+}
+
+// Reservoir keeps a uniform random sample of at most k of the items added to it, without
+// knowing their number in advance, with Vitter's algorithm R. Every item added so far has
+// the same probability of being in the sample. A Reservoir is not safe for concurrent use.
+//
+// Example:
+//
+//	reservoir := gofunc.NewReservoir[LogLine](100, rand.New(rand.NewSource(42)))
+//	for scanner.Scan() {
+//		reservoir.Add(parse(scanner.Text()))
+//	}
+//	sample := reservoir.Sample()
+type Reservoir[T any] struct {
+	k      int
+	r      *rand.Rand
+	sample []T
+	count  int
+}
+
+// NewReservoir creates an empty Reservoir keeping at most k items. A nil source uses the
+// global source of math/rand. Panics with an *ArgumentError if k is negative.
+func NewReservoir[T any](k int, r *rand.Rand) *Reservoir[T] {
+	if k < 0 {
+		panic(newArgumentError("k", "must not be negative"))
+	}
+	return &Reservoir[T]{k: k, r: randOrDefault(r), sample: make([]T, 0, k)}
+}
+
+// Add offers items to the reservoir.
+func (s *Reservoir[T]) Add(items ...T) {
+	for _, item := range items {
+		s.count++
+		if len(s.sample) < s.k {
+			s.sample = append(s.sample, item)
+		} else if j := s.r.Intn(s.count); j < s.k {
+			s.sample[j] = item
+		}
+	}
+}
+
+// Count returns the number of items added.
+func (s *Reservoir[T]) Count() int {
+	return s.count
+}
+
+// Sample returns a copy of the current sample, which holds all the items added if there
+// are at most k of them.
+func (s *Reservoir[T]) Sample() []T {
+	return append([]T{}, s.sample...)
+}
+
+// ReservoirSample reads a channel until it is closed and returns a uniform random sample
+// of at most k of its values, using a Reservoir. If the context is cancelled first, it
+// returns the sample of the values read so far with the context error.
+// A nil source uses the global source of math/rand.
+// Panics with an *ArgumentError if k is negative.
+//
+// Example:
+//
+//	r := rand.New(rand.NewSource(42))
+//	sample, err := gofunc.ReservoirSample(ctx, events, 1000, r)
+func ReservoirSample[T any](ctx context.Context, in <-chan T, k int, r *rand.Rand) ([]T, error) {
+	reservoir := NewReservoir[T](k, r)
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return reservoir.Sample(), nil
+			}
+			reservoir.Add(v)
+		case <-ctx.Done():
+			return reservoir.Sample(), ctx.Err()
+		}
+	}
+}
+
+// RandomSplit shuffles a copy of a slice and splits it in two, with the given fraction of
+// the elements in the first part, rounded to the nearest count, such as a training set
+// and a test set. The input slice is not modified.
+// A nil source uses the global source of math/rand.
+// Panics with an *ArgumentError if fraction is not between 0 and 1.
+//
+// Example:
+//
+//	r := rand.New(rand.NewSource(42))
+//	train, test := gofunc.RandomSplit(samples, 0.8, r)
+func RandomSplit[T any](s []T, fraction float64, r *rand.Rand) ([]T, []T) {
+	if !(fraction >= 0 && fraction <= 1) {
+		panic(newArgumentError("fraction", "must be between 0 and 1"))
+	}
+	shuffled := Shuffle(append([]T(nil), s...), r)
+	n := int(math.Round(fraction * float64(len(s))))
+	return shuffled[:n:n], shuffled[n:]
+}
+
+func checkWeights[T any](items []T, weights []float64) (float64, error) {
+	if len(items) == 0 {
+		return 0, ErrInputRequired
+	}
+	if len(weights) != len(items) {
+		return 0, newArgumentError("weights", "must have the same length as items")
+	}
+	total := 0.0
+	for _, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			return 0, newArgumentError("weights", "must be finite and not negative")
+		}
+		total += w
+	}
+	if !(total > 0) || math.IsInf(total, 1) {
+		return 0, newArgumentError("weights", "must have a positive and finite sum")
+	}
+	return total, nil
+}
+
+// globalSource is a rand.Source backed by the global source of math/rand, which is safe
+// for concurrent use.
+type globalSource struct{}
+
+func (globalSource) Int63() int64 {
+	return rand.Int63()
+}
+
+func (globalSource) Uint64() uint64 {
+	return rand.Uint64()
+}
+
+func (globalSource) Seed(int64) {}
+
+var globalRand = rand.New(globalSource{})
+
+func randOrDefault(r *rand.Rand) *rand.Rand {
+	if r == nil {
+		return globalRand
+	}
+	return r
+}
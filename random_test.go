@@ -0,0 +1,198 @@
+package gofunc
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewSource(42))
+}
+
+func Test_Random_Shuffle(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	shuffled := Shuffle(s, newTestRand())
+	assert.Equal(t, s, shuffled)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, shuffled)
+	assert.NotEqual(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, shuffled)
+
+	// the same seed gives the same order
+	assert.Equal(t, shuffled, Shuffle([]int{1, 2, 3, 4, 5, 6, 7, 8}, newTestRand()))
+	assert.Equal(t, []int{}, Shuffle([]int{}, nil))
+	assert.Len(t, Shuffle([]int{1, 2, 3}, nil), 3)
+}
+
+func Test_Random_Sample(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	sample, err := Sample(s, 4, newTestRand())
+	assert.NoError(t, err)
+	assert.Len(t, sample, 4)
+	assert.Len(t, ToSet(sample), 4)
+	assert.Subset(t, s, sample)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, s)
+	again, _ := Sample(s, 4, newTestRand())
+	assert.Equal(t, sample, again)
+
+	all, err := Sample(s, len(s), newTestRand())
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, s, all)
+	none, err := Sample(s, 0, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, none)
+
+	_, err = Sample(s, 11, nil)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = Sample(s, -1, nil)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	t.Run("uniform", func(t *testing.T) {
+		r := newTestRand()
+		counts := make([]int, 5)
+		for i := 0; i < 10000; i++ {
+			picked, _ := Sample([]int{0, 1, 2, 3, 4}, 2, r)
+			for _, v := range picked {
+				counts[v]++
+			}
+		}
+		for _, c := range counts {
+			assert.InDelta(t, 4000, c, 200)
+		}
+	})
+}
+
+func Test_Random_Choice(t *testing.T) {
+	r := newTestRand()
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		v, err := Choice([]string{"a", "b", "c"}, r)
+		assert.NoError(t, err)
+		seen[v] = true
+	}
+	assert.Len(t, seen, 3)
+
+	_, err := Choice([]string{}, r)
+	assert.ErrorIs(t, err, ErrInputRequired)
+}
+
+func Test_Random_WeightedChoice(t *testing.T) {
+	items := []string{"a", "b", "c", "never"}
+	weights := []float64{50, 30, 20, 0}
+	sampler, err := NewWeightedSampler(items, weights)
+	assert.NoError(t, err)
+
+	r := newTestRand()
+	direct, aliased := map[string]int{}, map[string]int{}
+	for i := 0; i < 20000; i++ {
+		v, err := WeightedChoice(items, weights, r)
+		assert.NoError(t, err)
+		direct[v]++
+		aliased[sampler.Sample(r)]++
+	}
+	for _, counts := range []map[string]int{direct, aliased} {
+		assert.InDelta(t, 10000, counts["a"], 300)
+		assert.InDelta(t, 6000, counts["b"], 300)
+		assert.InDelta(t, 4000, counts["c"], 300)
+		assert.Zero(t, counts["never"])
+	}
+
+	single, err := NewWeightedSampler([]int{7}, []float64{0.5})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, single.Sample(nil))
+
+	for _, weights := range [][]float64{{1}, {1, -1}, {0, 0}, {1, math.NaN()}, {1, math.Inf(1)}} {
+		_, err := WeightedChoice([]int{1, 2}, weights, r)
+		assert.ErrorIs(t, err, ErrInvalidArgument, "%v", weights)
+		_, err = NewWeightedSampler([]int{1, 2}, weights)
+		assert.ErrorIs(t, err, ErrInvalidArgument, "%v", weights)
+	}
+	_, err = WeightedChoice([]int{}, []float64{}, r)
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, err = NewWeightedSampler([]int{}, nil)
+	assert.ErrorIs(t, err, ErrInputRequired)
+}
+
+func Test_Random_Reservoir(t *testing.T) {
+	reservoir := NewReservoir[int](3, newTestRand())
+	assert.Equal(t, []int{}, reservoir.Sample())
+	reservoir.Add(1, 2)
+	assert.Equal(t, []int{1, 2}, reservoir.Sample())
+	reservoir.Add(3, 4, 5, 6, 7, 8, 9, 10)
+	assert.Equal(t, 10, reservoir.Count())
+	sample := reservoir.Sample()
+	assert.Len(t, sample, 3)
+	assert.Len(t, ToSet(sample), 3)
+
+	// the sample is a copy
+	sample[0] = -1
+	assert.NotContains(t, reservoir.Sample(), -1)
+
+	t.Run("uniform", func(t *testing.T) {
+		r := newTestRand()
+		counts := make([]int, 10)
+		for i := 0; i < 10000; i++ {
+			reservoir := NewReservoir[int](2, r)
+			reservoir.Add(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+			for _, v := range reservoir.Sample() {
+				counts[v]++
+			}
+		}
+		for _, c := range counts {
+			assert.InDelta(t, 2000, c, 150)
+		}
+	})
+
+	assert.Panics(t, func() { NewReservoir[int](-1, nil) })
+}
+
+func Test_Random_ReservoirSample(t *testing.T) {
+	ctx := context.Background()
+	sample, err := ReservoirSample(ctx, SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6}), 4, newTestRand())
+	assert.NoError(t, err)
+	assert.Len(t, sample, 4)
+	assert.Subset(t, []int{1, 2, 3, 4, 5, 6}, sample)
+
+	short, err := ReservoirSample(ctx, SliceToChan(ctx, []int{1, 2}), 4, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, short)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	in := make(chan int, 1)
+	in <- 1
+	go func() {
+		for len(in) > 0 {
+			runtime.Gosched()
+		}
+		cancel()
+	}()
+	partial, err := ReservoirSample(cancelled, in, 4, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{1}, partial)
+}
+
+func Test_Random_RandomSplit(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	train, test := RandomSplit(s, 0.8, newTestRand())
+	assert.Len(t, train, 8)
+	assert.Len(t, test, 2)
+	assert.ElementsMatch(t, s, append(train, test...))
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, s)
+
+	// appending to the first part does not overwrite the second
+	train = append(train, -1)
+	assert.NotContains(t, test, -1)
+
+	train, test = RandomSplit(s, 0.25, nil)
+	assert.Len(t, train, 3)
+	assert.Len(t, test, 7)
+	train, test = RandomSplit([]int{}, 0.5, nil)
+	assert.Empty(t, train)
+	assert.Empty(t, test)
+
+	assert.Panics(t, func() { RandomSplit(s, 1.5, nil) })
+	assert.Panics(t, func() { RandomSplit(s, math.NaN(), nil) })
+}
@@ -0,0 +1,188 @@
+package gofunc
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter controls how frequently events may happen.
+type RateLimiter interface {
+	// Allow reports whether an event may happen now, consuming the permission if so.
+	Allow() bool
+	// Wait blocks until an event may happen, or returns ctx.Err() if the context is done first.
+	Wait(ctx context.Context) error
+}
+
+// TokenBucketLimiter is a RateLimiter that refills a bucket of tokens at a steady rate.
+// Every event consumes a token, so bursts up to the bucket size are allowed while the
+// long-term rate stays bounded. It is safe for concurrent use.
+//
+// Example:
+//
+//	limiter := gofunc.NewTokenBucketLimiter(100, time.Second, 10, nil)
+//	// up to 10 events at once, 100 events per second on average
+//	if err := limiter.Wait(ctx); err != nil {
+//		return err
+//	}
+type TokenBucketLimiter struct {
+	clock Clock
+	rate  float64 // tokens refilled per nanosecond
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucketLimiter creates a TokenBucketLimiter allowing limit events per period,
+// with bursts of up to burst events. The bucket starts full.
+// clock may be nil to use SystemClock.
+// Panics with an *ArgumentError if limit, per or burst is not positive.
+func NewTokenBucketLimiter(limit int, per time.Duration, burst int, clock Clock) *TokenBucketLimiter {
+	if limit <= 0 {
+		panic(newArgumentError("limit", "must be positive"))
+	}
+	if per <= 0 {
+		panic(newArgumentError("per", "must be positive"))
+	}
+	if burst <= 0 {
+		panic(newArgumentError("burst", "must be positive"))
+	}
+	if clock == nil {
+		clock = SystemClock()
+	}
+	return &TokenBucketLimiter{
+		clock:  clock,
+		rate:   float64(limit) / float64(per),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   clock.Now(),
+	}
+}
+
+// Allow reports whether a token is available, consuming it if so.
+func (l *TokenBucketLimiter) Allow() bool {
+	_, ok := l.reserve()
+	return ok
+}
+
+// Wait blocks until a token is available and consumes it.
+func (l *TokenBucketLimiter) Wait(ctx context.Context) error {
+	for {
+		delay, ok := l.reserve()
+		if ok {
+			return nil
+		}
+		if err := l.clock.Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// Tokens returns the number of tokens currently available, including fractions.
+func (l *TokenBucketLimiter) Tokens() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	return l.tokens
+}
+
+// reserve consumes a token if one is available, or returns how long until one is.
+func (l *TokenBucketLimiter) reserve() (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0, true
+	}
+	return time.Duration(math.Ceil((1 - l.tokens) / l.rate)), false
+}
+
+func (l *TokenBucketLimiter) refill() {
+	now := l.clock.Now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+float64(elapsed)*l.rate)
+		l.last = now
+	}
+}
+
+// SlidingWindowLimiter is a RateLimiter allowing at most a fixed number of events in any
+// window of time ending now. Unlike fixed windows, it never lets twice the limit through
+// around a window boundary. It keeps the time of the last limit events, so its memory use
+// grows with the limit. It is safe for concurrent use.
+//
+// Example:
+//
+//	limiter := gofunc.NewSlidingWindowLimiter(5, time.Minute, nil)
+//	if !limiter.Allow() {
+//		return errTooManyLoginAttempts
+//	}
+type SlidingWindowLimiter struct {
+	clock  Clock
+	window time.Duration
+
+	mu     sync.Mutex
+	events []time.Time // ring buffer of the last allowed events
+	start  int         // index of the oldest event
+	count  int
+}
+
+// NewSlidingWindowLimiter creates a SlidingWindowLimiter allowing limit events per window.
+// clock may be nil to use SystemClock.
+// Panics with an *ArgumentError if limit or window is not positive.
+func NewSlidingWindowLimiter(limit int, window time.Duration, clock Clock) *SlidingWindowLimiter {
+	if limit <= 0 {
+		panic(newArgumentError("limit", "must be positive"))
+	}
+	if window <= 0 {
+		panic(newArgumentError("window", "must be positive"))
+	}
+	if clock == nil {
+		clock = SystemClock()
+	}
+	return &SlidingWindowLimiter{
+		clock:  clock,
+		window: window,
+		events: make([]time.Time, limit),
+	}
+}
+
+// Allow reports whether an event may happen in the current window, recording it if so.
+func (l *SlidingWindowLimiter) Allow() bool {
+	_, ok := l.reserve()
+	return ok
+}
+
+// Wait blocks until the oldest event leaves the window, then records a new event.
+func (l *SlidingWindowLimiter) Wait(ctx context.Context) error {
+	for {
+		delay, ok := l.reserve()
+		if ok {
+			return nil
+		}
+		if err := l.clock.Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve records an event if the window has room, or returns how long until it has.
+func (l *SlidingWindowLimiter) reserve() (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	for l.count > 0 && !now.Before(l.events[l.start].Add(l.window)) {
+		l.start = (l.start + 1) % len(l.events)
+		l.count--
+	}
+	if l.count == len(l.events) {
+		return l.events[l.start].Add(l.window).Sub(now), false
+	}
+	l.events[(l.start+l.count)%len(l.events)] = now
+	l.count++
+	return 0, true
+}
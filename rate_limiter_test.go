@@ -0,0 +1,110 @@
+package gofunc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RateLimiter_TokenBucket(t *testing.T) {
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(10, time.Second, 3, clock)
+
+	// the bucket starts full
+	assert.True(t, l.Allow())
+	assert.True(t, l.Allow())
+	assert.True(t, l.Allow())
+	assert.False(t, l.Allow())
+
+	// one token every 100ms
+	clock.Advance(50 * time.Millisecond)
+	assert.False(t, l.Allow())
+	assert.InDelta(t, 0.5, l.Tokens(), 1e-9)
+	clock.Advance(50 * time.Millisecond)
+	assert.True(t, l.Allow())
+
+	// tokens never exceed the burst size
+	clock.Advance(time.Hour)
+	assert.InDelta(t, 3, l.Tokens(), 1e-9)
+}
+
+func Test_RateLimiter_TokenBucketHighRate(t *testing.T) {
+	// more events than nanoseconds in the period: the rate must not be truncated to 0
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(3000, time.Microsecond, 1, clock)
+
+	assert.True(t, l.Allow())
+	assert.False(t, l.Allow())
+	clock.Advance(time.Nanosecond)
+	assert.InDelta(t, 1, l.Tokens(), 1e-9)
+	assert.True(t, l.Allow())
+
+	// a rate that does not divide the period evenly
+	l = NewTokenBucketLimiter(3, time.Second, 2, clock)
+	assert.True(t, l.Allow())
+	assert.True(t, l.Allow())
+	clock.Advance(time.Second / 2)
+	assert.InDelta(t, 1.5, l.Tokens(), 1e-9)
+}
+
+func Test_RateLimiter_TokenBucketWait(t *testing.T) {
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(10, time.Second, 1, clock)
+	ctx := context.Background()
+
+	assert.NoError(t, l.Wait(ctx))
+	assert.NoError(t, l.Wait(ctx))
+	assert.NoError(t, l.Wait(ctx))
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 100 * time.Millisecond}, clock.Sleeps())
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, l.Wait(canceled), context.Canceled)
+}
+
+func Test_RateLimiter_SlidingWindow(t *testing.T) {
+	clock := newFakeClock()
+	l := NewSlidingWindowLimiter(2, time.Minute, clock)
+
+	assert.True(t, l.Allow())
+	clock.Advance(30 * time.Second)
+	assert.True(t, l.Allow())
+	assert.False(t, l.Allow())
+
+	// the first event leaves the window after a minute
+	clock.Advance(29 * time.Second)
+	assert.False(t, l.Allow())
+	clock.Advance(time.Second)
+	assert.True(t, l.Allow())
+	assert.False(t, l.Allow())
+
+	// Wait sleeps until the oldest event leaves the window
+	assert.NoError(t, l.Wait(context.Background()))
+	assert.Equal(t, []time.Duration{30 * time.Second}, clock.Sleeps())
+}
+
+func Test_RateLimiter_Interface(t *testing.T) {
+	limiters := []RateLimiter{
+		NewTokenBucketLimiter(1, time.Hour, 2, nil),
+		NewSlidingWindowLimiter(2, time.Hour, nil),
+	}
+	for _, l := range limiters {
+		assert.NoError(t, l.Wait(context.Background()))
+		assert.True(t, l.Allow())
+		assert.False(t, l.Allow())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		assert.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+		cancel()
+	}
+}
+
+func Test_RateLimiter_InvalidArguments(t *testing.T) {
+	assert.Panics(t, func() { NewTokenBucketLimiter(0, time.Second, 1, nil) })
+	assert.Panics(t, func() { NewTokenBucketLimiter(1, 0, 1, nil) })
+	assert.Panics(t, func() { NewTokenBucketLimiter(1, time.Second, 0, nil) })
+	assert.Panics(t, func() { NewSlidingWindowLimiter(0, time.Second, nil) })
+	assert.Panics(t, func() { NewSlidingWindowLimiter(1, 0, nil) })
+}
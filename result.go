@@ -0,0 +1,193 @@
+package gofunc
+
+// Result holds either a value (Ok) or an error (Err).
+// It is the value-type equivalent of Go's (T, error) idiom, which makes it easy to chain
+// fallible steps or collect outcomes in slices and channels.
+// The zero value is Ok with the zero value of T.
+//
+// Example:
+//
+//	r := gofunc.ResultOf(strconv.Atoi("42"))
+//	doubled := gofunc.ResultMap(r, func(n int) int { return n * 2 })
+//	// doubled.Unwrap() is 84
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok creates a successful Result holding the given value.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err creates a failed Result holding the given error.
+// The error must not be nil, otherwise the Result is Ok.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// ResultOf creates a Result from the (T, error) idiom.
+// The Result is Err if err is not nil, Ok otherwise.
+//
+// Example:
+//
+//	r := gofunc.ResultOf(strconv.Atoi("x"))
+//	// r.IsErr() is true
+func ResultOf[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// IsOk reports whether the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and error in Go's (T, error) idiom.
+// The value is the zero value of T if the Result is Err.
+func (r Result[T]) Get() (T, error) {
+	if r.err != nil {
+		var zeroT T
+		return zeroT, r.err
+	}
+	return r.value, nil
+}
+
+// Err returns the error of the Result, or nil if it is Ok.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value, or panics with the error if the Result is Err, like Must.
+func (r Result[T]) Unwrap() T {
+	return Must(r.Get())
+}
+
+// UnwrapOr returns the value, or defaultVal if the Result is Err.
+func (r Result[T]) UnwrapOr(defaultVal T) T {
+	if r.err != nil {
+		return defaultVal
+	}
+	return r.value
+}
+
+// Option converts the Result into an Option, discarding the error.
+func (r Result[T]) Option() Option[T] {
+	if r.err != nil {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// ResultMap applies fn to the value of the Result, if any.
+// Returns the original error if the Result is Err, without calling fn.
+func ResultMap[T, U any](r Result[T], fn func(t T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.value))
+}
+
+// ResultAndThen applies fn, which itself may fail, to the value of the Result.
+// Returns the original error if the Result is Err, without calling fn.
+//
+// Example:
+//
+//	raw := gofunc.Ok(" 8080 ")
+//	port := gofunc.ResultAndThen(raw, func(s string) gofunc.Result[int] {
+//		return gofunc.ResultOf(strconv.Atoi(strings.TrimSpace(s)))
+//	})
+//	// port.Unwrap() is 8080
+func ResultAndThen[T, U any](r Result[T], fn func(t T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return fn(r.value)
+}
+
+// TryMap applies fn to every element of a slice and returns the results in order.
+// It stops at the first error and returns it along with a nil slice.
+//
+// Example:
+//
+//	numbers, err := gofunc.TryMap([]string{"1", "2", "x"}, strconv.Atoi)
+//	// numbers is nil, err is the error for "x"
+func TryMap[T, U any](s []T, fn func(t T) (U, error)) ([]U, error) {
+	result := make([]U, len(s))
+	for i := range s {
+		v, err := fn(s[i])
+		if err != nil {
+			return nil, err
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+// TryMapCollect applies fn to every element of a slice without stopping on errors.
+// It returns the results of the successful calls in order, along with all errors combined
+// with Combine, or a nil error if every call succeeded.
+//
+// Example:
+//
+//	numbers, err := gofunc.TryMapCollect([]string{"1", "x", "3", "y"}, strconv.Atoi)
+//	// numbers is []int{1, 3}, err holds the errors for "x" and "y"
+func TryMapCollect[T, U any](s []T, fn func(t T) (U, error)) ([]U, error) {
+	var (
+		result = make([]U, 0, len(s))
+		errs   []error
+	)
+	for i := range s {
+		v, err := fn(s[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result = append(result, v)
+	}
+	return result, Combine(errs...)
+}
+
+// TryFilter returns the elements of a slice for which the fallible predicate returns true.
+// It stops at the first error and returns it along with a nil slice.
+func TryFilter[T any](s []T, pred func(t T) (bool, error)) ([]T, error) {
+	result := make([]T, 0, len(s))
+	for i := range s {
+		keep, err := pred(s[i])
+		if err != nil {
+			return nil, err
+		}
+		if keep {
+			result = append(result, s[i])
+		}
+	}
+	return result, nil
+}
+
+// TryFilterCollect returns the elements of a slice for which the fallible predicate returns true,
+// without stopping on errors. Elements whose predicate fails are left out, and all errors are
+// combined with Combine, or the error is nil if every call succeeded.
+func TryFilterCollect[T any](s []T, pred func(t T) (bool, error)) ([]T, error) {
+	var (
+		result = make([]T, 0, len(s))
+		errs   []error
+	)
+	for i := range s {
+		keep, err := pred(s[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if keep {
+			result = append(result, s[i])
+		}
+	}
+	return result, Combine(errs...)
+}
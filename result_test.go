@@ -0,0 +1,107 @@
+package gofunc
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Result_OkErr(t *testing.T) {
+	ok := Ok(42)
+	assert.True(t, ok.IsOk())
+	assert.False(t, ok.IsErr())
+	assert.Nil(t, ok.Err())
+	assert.Equal(t, 42, ok.Unwrap())
+	assert.Equal(t, 42, ok.UnwrapOr(0))
+	assert.Equal(t, Some(42), ok.Option())
+	v, e := ok.Get()
+	assert.NoError(t, e)
+	assert.Equal(t, 42, v)
+
+	errBoom := errors.New("boom")
+	failed := Err[int](errBoom)
+	assert.False(t, failed.IsOk())
+	assert.True(t, failed.IsErr())
+	assert.ErrorIs(t, failed.Err(), errBoom)
+	assert.Equal(t, -1, failed.UnwrapOr(-1))
+	assert.Equal(t, None[int](), failed.Option())
+	v, e = failed.Get()
+	assert.ErrorIs(t, e, errBoom)
+	assert.Equal(t, 0, v)
+	assert.PanicsWithError(t, "boom", func() { failed.Unwrap() })
+}
+
+func Test_Result_ResultOf(t *testing.T) {
+	assert.Equal(t, Ok(42), ResultOf(strconv.Atoi("42")))
+	assert.True(t, ResultOf(strconv.Atoi("x")).IsErr())
+}
+
+func Test_Result_MapAndThen(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	assert.Equal(t, Ok(84), ResultMap(Ok(42), double))
+
+	errBoom := errors.New("boom")
+	assert.ErrorIs(t, ResultMap(Err[int](errBoom), double).Err(), errBoom)
+
+	parse := func(s string) Result[int] { return ResultOf(strconv.Atoi(s)) }
+	assert.Equal(t, Ok(8080), ResultAndThen(Ok("8080"), parse))
+	assert.True(t, ResultAndThen(Ok("x"), parse).IsErr())
+	assert.ErrorIs(t, ResultAndThen(Err[string](errBoom), parse).Err(), errBoom)
+}
+
+func Test_Result_TryMap(t *testing.T) {
+	numbers, e := TryMap([]string{"1", "2", "3"}, strconv.Atoi)
+	assert.NoError(t, e)
+	assert.Equal(t, []int{1, 2, 3}, numbers)
+
+	calls := 0
+	numbers, e = TryMap([]string{"1", "x", "3"}, func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	})
+	assert.Error(t, e)
+	assert.Nil(t, numbers)
+	assert.Equal(t, 2, calls)
+
+	numbers, e = TryMap([]string{}, strconv.Atoi)
+	assert.NoError(t, e)
+	assert.Equal(t, []int{}, numbers)
+}
+
+func Test_Result_TryMapCollect(t *testing.T) {
+	numbers, e := TryMapCollect([]string{"1", "x", "3", "y"}, strconv.Atoi)
+	assert.Equal(t, []int{1, 3}, numbers)
+	var numErr *strconv.NumError
+	assert.ErrorAs(t, e, &numErr)
+	assert.Contains(t, e.Error(), `"x"`)
+	assert.Contains(t, e.Error(), `"y"`)
+
+	numbers, e = TryMapCollect([]string{"1", "2"}, strconv.Atoi)
+	assert.NoError(t, e)
+	assert.Equal(t, []int{1, 2}, numbers)
+}
+
+func Test_Result_TryFilter(t *testing.T) {
+	isEven := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+
+	even, e := TryFilter([]string{"1", "2", "3", "4"}, isEven)
+	assert.NoError(t, e)
+	assert.Equal(t, []string{"2", "4"}, even)
+
+	even, e = TryFilter([]string{"2", "x", "4"}, isEven)
+	assert.Error(t, e)
+	assert.Nil(t, even)
+
+	even, e = TryFilterCollect([]string{"2", "x", "4", "5"}, isEven)
+	assert.Error(t, e)
+	assert.Equal(t, []string{"2", "4"}, even)
+
+	even, e = TryFilterCollect([]string{"2", "3"}, isEven)
+	assert.NoError(t, e)
+	assert.Equal(t, []string{"2"}, even)
+}
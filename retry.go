@@ -0,0 +1,162 @@
+package gofunc
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff computes how long to wait before the next attempt of a retried operation.
+// attempt is the number of the attempt that just failed, starting at 1, and prev is
+// the delay returned for the previous attempt, or 0 after the first one.
+type Backoff interface {
+	Delay(attempt int, prev time.Duration) time.Duration
+}
+
+// BackoffFunc adapts an ordinary function to the Backoff interface.
+type BackoffFunc func(attempt int, prev time.Duration) time.Duration
+
+// Delay calls f(attempt, prev).
+func (f BackoffFunc) Delay(attempt int, prev time.Duration) time.Duration {
+	return f(attempt, prev)
+}
+
+// ConstantBackoff waits the same duration between every attempt.
+func ConstantBackoff(d time.Duration) Backoff {
+	return BackoffFunc(func(int, time.Duration) time.Duration { return d })
+}
+
+// ExponentialBackoff waits initial after the first attempt, then multiplies the delay
+// by multiplier after every further attempt, never exceeding max.
+//
+// Example:
+//
+//	backoff := gofunc.ExponentialBackoff(100*time.Millisecond, 5*time.Second, 2)
+//	// delays are 100ms, 200ms, 400ms, ... up to 5s
+func ExponentialBackoff(initial, max time.Duration, multiplier float64) Backoff {
+	return BackoffFunc(func(attempt int, _ time.Duration) time.Duration {
+		d := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+		if d > float64(max) {
+			return max
+		}
+		return time.Duration(d)
+	})
+}
+
+// DecorrelatedJitterBackoff waits a random duration between base and three times the
+// previous delay, never exceeding max. Randomizing the delays spreads out retries from
+// many clients failing at the same time.
+// Random numbers are drawn from r, or from the math/rand package if r is nil.
+// A *rand.Rand is not safe for concurrent use, so share r only between sequential retries.
+func DecorrelatedJitterBackoff(base, max time.Duration, r *rand.Rand) Backoff {
+	int63n := rand.Int63n
+	if r != nil {
+		int63n = r.Int63n
+	}
+	return BackoffFunc(func(_ int, prev time.Duration) time.Duration {
+		upper := 3 * prev
+		if upper <= base {
+			return minOf2(base, max)
+		}
+		return minOf2(base+time.Duration(int63n(int64(upper-base))), max)
+	})
+}
+
+// RetryOptions configures Retry and RetryValue.
+// The zero value retries every error up to 3 attempts with exponential backoff.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of calls, including the first one.
+	// Defaults to 3 when not positive.
+	MaxAttempts int
+	// MaxElapsedTime stops retrying once waiting for the next attempt would exceed
+	// this duration since the first call. Zero means no limit.
+	MaxElapsedTime time.Duration
+	// Backoff computes the delay between attempts.
+	// Defaults to ExponentialBackoff(100ms, 10s, 2).
+	Backoff Backoff
+	// RetryIf reports whether an error is worth retrying.
+	// Errors it rejects are returned immediately. Defaults to retrying every error.
+	RetryIf func(err error) bool
+	// OnAttempt is called after every attempt with its number, starting at 1, its error,
+	// and the delay before the next attempt, which is 0 if there is none.
+	// It is the place for logging and metrics.
+	OnAttempt func(attempt int, err error, nextDelay time.Duration)
+	// Clock is used to read the time and sleep between attempts.
+	// Defaults to SystemClock.
+	Clock Clock
+}
+
+// Retry calls fn until it succeeds, following the retry policy in opts.
+// Returns nil on success, the error itself if RetryIf rejects it, or a *RetryError
+// once the attempts, the elapsed time or the context run out.
+//
+// Example:
+//
+//	err := gofunc.Retry(ctx, func(ctx context.Context) error {
+//		return client.Ping(ctx)
+//	}, gofunc.RetryOptions{MaxAttempts: 5})
+func Retry(ctx context.Context, fn func(ctx context.Context) error, opts RetryOptions) error {
+	_, err := RetryValue(ctx, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	}, opts)
+	return err
+}
+
+// RetryValue calls fn until it succeeds and returns its result, following the retry
+// policy in opts. Errors are reported the same way as Retry.
+//
+// Example:
+//
+//	user, err := gofunc.RetryValue(ctx, func(ctx context.Context) (User, error) {
+//		return client.GetUser(ctx, id)
+//	}, gofunc.RetryOptions{
+//		Backoff: gofunc.DecorrelatedJitterBackoff(50*time.Millisecond, 2*time.Second, nil),
+//		RetryIf: isTemporary,
+//	})
+func RetryValue[T any](ctx context.Context, fn func(ctx context.Context) (T, error), opts RetryOptions) (T, error) {
+	var (
+		maxAttempts = If(opts.MaxAttempts > 0, opts.MaxAttempts, 3)
+		backoff     = opts.Backoff
+		clock       = opts.Clock
+		start       time.Time
+		delay       time.Duration
+		zeroT       T
+	)
+	if backoff == nil {
+		backoff = ExponentialBackoff(100*time.Millisecond, 10*time.Second, 2)
+	}
+	if clock == nil {
+		clock = SystemClock()
+	}
+	start = clock.Now()
+
+	for attempt := 1; ; attempt++ {
+		v, err := fn(ctx)
+		if err == nil {
+			if opts.OnAttempt != nil {
+				opts.OnAttempt(attempt, nil, 0)
+			}
+			return v, nil
+		}
+		if opts.RetryIf != nil && !opts.RetryIf(err) {
+			if opts.OnAttempt != nil {
+				opts.OnAttempt(attempt, err, 0)
+			}
+			return zeroT, err
+		}
+
+		delay = backoff.Delay(attempt, delay)
+		giveUp := attempt >= maxAttempts ||
+			(opts.MaxElapsedTime > 0 && clock.Now().Add(delay).Sub(start) > opts.MaxElapsedTime)
+		if opts.OnAttempt != nil {
+			opts.OnAttempt(attempt, err, If(giveUp, time.Duration(0), delay))
+		}
+		if giveUp {
+			return zeroT, &RetryError{Attempts: attempt, Err: err}
+		}
+		if sleepErr := clock.Sleep(ctx, delay); sleepErr != nil {
+			return zeroT, &RetryError{Attempts: attempt, Err: Combine(err, sleepErr)}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package gofunc
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Retry_Backoff(t *testing.T) {
+	constant := ConstantBackoff(time.Second)
+	assert.Equal(t, time.Second, constant.Delay(1, 0))
+	assert.Equal(t, time.Second, constant.Delay(10, time.Second))
+
+	exp := ExponentialBackoff(100*time.Millisecond, time.Second, 2)
+	delays := []time.Duration{}
+	for attempt := 1; attempt <= 6; attempt++ {
+		delays = append(delays, exp.Delay(attempt, 0))
+	}
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, time.Second, time.Second,
+	}, delays)
+
+	jitter := DecorrelatedJitterBackoff(100*time.Millisecond, time.Second, rand.New(rand.NewSource(1)))
+	prev := time.Duration(0)
+	for attempt := 1; attempt <= 50; attempt++ {
+		d := jitter.Delay(attempt, prev)
+		assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+		assert.LessOrEqual(t, d, time.Second)
+		if prev > 0 {
+			assert.LessOrEqual(t, d, 3*prev)
+		}
+		prev = d
+	}
+
+	global := DecorrelatedJitterBackoff(time.Millisecond, time.Second, nil)
+	assert.Equal(t, time.Millisecond, global.Delay(1, 0))
+	assert.LessOrEqual(t, global.Delay(2, 100*time.Millisecond), 300*time.Millisecond)
+}
+
+func Test_Retry_SucceedsAfterFailures(t *testing.T) {
+	clock := newFakeClock()
+	errTemporary := errors.New("temporary")
+	calls := 0
+	attempts := []int{}
+
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return errTemporary
+		}
+		return nil
+	}, RetryOptions{
+		MaxAttempts: 5,
+		Backoff:     ExponentialBackoff(time.Second, time.Minute, 2),
+		Clock:       clock,
+		OnAttempt: func(attempt int, err error, nextDelay time.Duration) {
+			attempts = append(attempts, attempt)
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []int{1, 2, 3}, attempts)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, clock.Sleeps())
+}
+
+func Test_Retry_GivesUpAfterMaxAttempts(t *testing.T) {
+	clock := newFakeClock()
+	errTemporary := errors.New("temporary")
+	delays := []time.Duration{}
+
+	v, err := RetryValue(context.Background(), func(ctx context.Context) (int, error) {
+		return 0, errTemporary
+	}, RetryOptions{
+		Clock: clock,
+		OnAttempt: func(attempt int, err error, nextDelay time.Duration) {
+			assert.ErrorIs(t, err, errTemporary)
+			delays = append(delays, nextDelay)
+		},
+	})
+
+	assert.Equal(t, 0, v)
+	assert.ErrorIs(t, err, errTemporary)
+	var retryErr *RetryError
+	assert.ErrorAs(t, err, &retryErr)
+	assert.Equal(t, 3, retryErr.Attempts)
+	assert.Equal(t, "giving up after 3 attempt(s): temporary", err.Error())
+	// Default backoff, and no delay announced for the last attempt
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 0}, delays)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, clock.Sleeps())
+}
+
+func Test_Retry_RetryIf(t *testing.T) {
+	errPermanent := errors.New("permanent")
+	calls := 0
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		calls++
+		return errPermanent
+	}, RetryOptions{
+		Clock:   newFakeClock(),
+		RetryIf: func(err error) bool { return !errors.Is(err, errPermanent) },
+	})
+
+	assert.Equal(t, errPermanent, err)
+	assert.Equal(t, 1, calls)
+}
+
+func Test_Retry_MaxElapsedTime(t *testing.T) {
+	clock := newFakeClock()
+	calls := 0
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		calls++
+		clock.Advance(time.Second) // each call takes a second
+		return errors.New("slow failure")
+	}, RetryOptions{
+		MaxAttempts:    100,
+		MaxElapsedTime: 10 * time.Second,
+		Backoff:        ConstantBackoff(2 * time.Second),
+		Clock:          clock,
+	})
+
+	var retryErr *RetryError
+	assert.ErrorAs(t, err, &retryErr)
+	// 1s call + 2s wait per attempt: the fourth wait would end at 12s
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, 4, retryErr.Attempts)
+}
+
+func Test_Retry_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	errTemporary := errors.New("temporary")
+	calls := 0
+	err := Retry(ctx, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return errTemporary
+	}, RetryOptions{MaxAttempts: 10, Clock: newFakeClock()})
+
+	assert.Equal(t, 1, calls)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, err, errTemporary)
+}
+
+func Test_Retry_SystemClock(t *testing.T) {
+	calls := 0
+	v, err := RetryValue(context.Background(), func(ctx context.Context) (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errors.New("first call fails")
+		}
+		return "ok", nil
+	}, RetryOptions{Backoff: ConstantBackoff(time.Millisecond)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", v)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,167 @@
+package gofunc
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// RunningStats accumulates the count, mean, variance, minimum and maximum of a stream of
+// values in constant memory, without buffering them into a slice. The mean and variance
+// are updated with Welford's algorithm, like Variance does for a slice.
+// Accumulators filled by different goroutines can be combined with Merge, and saved or
+// sent elsewhere with MarshalBinary. The zero value is empty and ready to use.
+// A RunningStats is not safe for concurrent use.
+//
+// Example:
+//
+//	var stats gofunc.RunningStats[time.Duration]
+//	for latency := range latencies {
+//		stats.Add(latency)
+//	}
+//	mean, _ := stats.Mean()
+//	max, _ := stats.Max()
+type RunningStats[T Number] struct {
+	count    int
+	mean, m2 float64
+	min, max T
+}
+
+// Add adds values to the accumulator.
+func (s *RunningStats[T]) Add(values ...T) {
+	for _, v := range values {
+		if s.count == 0 || v < s.min {
+			s.min = v
+		}
+		if s.count == 0 || v > s.max {
+			s.max = v
+		}
+		s.count++
+		x := float64(v)
+		delta := x - s.mean
+		s.mean += delta / float64(s.count)
+		s.m2 += delta * (x - s.mean)
+	}
+}
+
+// Merge adds every value accumulated by other, as if they had been added to s.
+// other is not modified.
+func (s *RunningStats[T]) Merge(other *RunningStats[T]) {
+	if other.count == 0 {
+		return
+	}
+	if s.count == 0 {
+		*s = *other
+		return
+	}
+	// Chan et al.'s formula for combining the moments of two partitions
+	n := s.count + other.count
+	delta := other.mean - s.mean
+	s.mean += delta * float64(other.count) / float64(n)
+	s.m2 += other.m2 + delta*delta*float64(s.count)*float64(other.count)/float64(n)
+	s.min = minOf2(s.min, other.min)
+	s.max = maxOf2(s.max, other.max)
+	s.count = n
+}
+
+// Count returns the number of values added.
+func (s *RunningStats[T]) Count() int {
+	return s.count
+}
+
+// Mean returns the mean of the values. Returns ErrInputRequired if no value was added.
+func (s *RunningStats[T]) Mean() (float64, error) {
+	if s.count == 0 {
+		return 0, ErrInputRequired
+	}
+	return s.mean, nil
+}
+
+// Variance returns the population variance of the values.
+// Returns ErrInputRequired if no value was added.
+func (s *RunningStats[T]) Variance() (float64, error) {
+	if s.count == 0 {
+		return 0, ErrInputRequired
+	}
+	return s.m2 / float64(s.count), nil
+}
+
+// SampleVariance returns the sample variance of the values.
+// Returns an *ArgumentError if fewer than two values were added.
+func (s *RunningStats[T]) SampleVariance() (float64, error) {
+	if s.count < 2 {
+		return 0, newArgumentError("s", "at least two values are required")
+	}
+	return s.m2 / float64(s.count-1), nil
+}
+
+// StdDev returns the population standard deviation of the values.
+// Returns ErrInputRequired if no value was added.
+func (s *RunningStats[T]) StdDev() (float64, error) {
+	v, err := s.Variance()
+	return math.Sqrt(v), err
+}
+
+// SampleStdDev returns the sample standard deviation of the values.
+// Returns an *ArgumentError if fewer than two values were added.
+func (s *RunningStats[T]) SampleStdDev() (float64, error) {
+	v, err := s.SampleVariance()
+	return math.Sqrt(v), err
+}
+
+// Min returns the smallest value. Returns ErrInputRequired if no value was added.
+func (s *RunningStats[T]) Min() (T, error) {
+	if s.count == 0 {
+		return 0, ErrInputRequired
+	}
+	return s.min, nil
+}
+
+// Max returns the largest value. Returns ErrInputRequired if no value was added.
+func (s *RunningStats[T]) Max() (T, error) {
+	if s.count == 0 {
+		return 0, ErrInputRequired
+	}
+	return s.max, nil
+}
+
+// MarshalBinary encodes the accumulator, implementing encoding.BinaryMarshaler.
+func (s *RunningStats[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 40)
+	data = binary.BigEndian.AppendUint64(data, uint64(s.count))
+	data = binary.BigEndian.AppendUint64(data, math.Float64bits(s.mean))
+	data = binary.BigEndian.AppendUint64(data, math.Float64bits(s.m2))
+	data = binary.BigEndian.AppendUint64(data, numberToBits(s.min))
+	data = binary.BigEndian.AppendUint64(data, numberToBits(s.max))
+	return data, nil
+}
+
+// UnmarshalBinary decodes an accumulator encoded by MarshalBinary for the same type T,
+// implementing encoding.BinaryUnmarshaler.
+// Returns an *ArgumentError if the data is not a valid encoding.
+func (s *RunningStats[T]) UnmarshalBinary(data []byte) error {
+	if len(data) != 40 {
+		return newArgumentError("data", "invalid RunningStats encoding")
+	}
+	s.count = int(binary.BigEndian.Uint64(data))
+	s.mean = math.Float64frombits(binary.BigEndian.Uint64(data[8:]))
+	s.m2 = math.Float64frombits(binary.BigEndian.Uint64(data[16:]))
+	s.min = numberFromBits[T](binary.BigEndian.Uint64(data[24:]))
+	s.max = numberFromBits[T](binary.BigEndian.Uint64(data[32:]))
+	return nil
+}
+
+// numberToBits encodes any number in 64 bits without losing information.
+func numberToBits[T Number](v T) uint64 {
+	if isFloat[T]() {
+		return math.Float64bits(float64(v))
+	}
+	// two's complement keeps negative integers intact through the round trip
+	return uint64(v)
+}
+
+func numberFromBits[T Number](bits uint64) T {
+	if isFloat[T]() {
+		return T(math.Float64frombits(bits))
+	}
+	return T(bits)
+}
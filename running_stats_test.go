@@ -0,0 +1,100 @@
+package gofunc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RunningStats_Add(t *testing.T) {
+	var s RunningStats[int]
+	_, err := s.Mean()
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, err = s.Min()
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, err = s.StdDev()
+	assert.ErrorIs(t, err, ErrInputRequired)
+
+	s.Add(2, 4, 4, 4)
+	s.Add(5, 5, 7, 9)
+	assert.Equal(t, 8, s.Count())
+	mean, err := s.Mean()
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, mean)
+	v, err := s.Variance()
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, v)
+	sd, err := s.StdDev()
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, sd)
+	sv, err := s.SampleVariance()
+	assert.NoError(t, err)
+	assert.InDelta(t, 32.0/7, sv, 1e-12)
+	ssd, err := s.SampleStdDev()
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Sqrt(32.0/7), ssd, 1e-12)
+	min, err := s.Min()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, min)
+	max, err := s.Max()
+	assert.NoError(t, err)
+	assert.Equal(t, 9, max)
+
+	var single RunningStats[float64]
+	single.Add(-1.5)
+	_, err = single.SampleVariance()
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	min2, _ := single.Min()
+	max2, _ := single.Max()
+	assert.Equal(t, -1.5, min2)
+	assert.Equal(t, -1.5, max2)
+}
+
+func Test_RunningStats_Merge(t *testing.T) {
+	values := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16, 1e9 - 3}
+	var whole, left, right, empty RunningStats[float64]
+	whole.Add(values...)
+	left.Add(values[:2]...)
+	right.Add(values[2:]...)
+
+	left.Merge(&right)
+	left.Merge(&empty)
+	assert.Equal(t, whole.Count(), left.Count())
+	wantMean, _ := whole.Mean()
+	gotMean, _ := left.Mean()
+	assert.InDelta(t, wantMean, gotMean, 1e-6)
+	wantVar, _ := whole.SampleVariance()
+	gotVar, _ := left.SampleVariance()
+	assert.InDelta(t, wantVar, gotVar, 1e-6)
+	min, _ := left.Min()
+	max, _ := left.Max()
+	assert.Equal(t, 1e9-3, min)
+	assert.Equal(t, 1e9+16, max)
+	assert.Equal(t, 3, right.Count())
+
+	empty.Merge(&right)
+	assert.Equal(t, 3, empty.Count())
+}
+
+func Test_RunningStats_MarshalBinary(t *testing.T) {
+	var s RunningStats[int64]
+	s.Add(-7, 3, 12)
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded RunningStats[int64]
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, s, decoded)
+	min, _ := decoded.Min()
+	assert.Equal(t, int64(-7), min)
+
+	var floats RunningStats[float32]
+	floats.Add(0.1, -2.5)
+	data, _ = floats.MarshalBinary()
+	var decodedFloats RunningStats[float32]
+	assert.NoError(t, decodedFloats.UnmarshalBinary(data))
+	assert.Equal(t, floats, decodedFloats)
+
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:10]), ErrInvalidArgument)
+}
@@ -0,0 +1,252 @@
+package gofunc
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog estimates the number of distinct items of a stream in a few kilobytes,
+// however many items there are. Its standard error is about 1.04/sqrt(2^precision),
+// so 0.8% with a precision of 14. Sketches filled by different goroutines can be
+// combined with Merge, and saved or sent elsewhere with MarshalBinary.
+// A HyperLogLog is not safe for concurrent use.
+//
+// Example:
+//
+//	visitors := gofunc.NewHyperLogLog(14)
+//	for request := range requests {
+//		visitors.AddString(request.UserID)
+//	}
+//	fmt.Println(visitors.Estimate(), "distinct visitors")
+type HyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+// NewHyperLogLog creates an empty HyperLogLog using 2^precision bytes of registers.
+// Panics with an *ArgumentError if precision is not between 4 and 18.
+func NewHyperLogLog(precision int) *HyperLogLog {
+	if precision < 4 || precision > 18 {
+		panic(newArgumentError("precision", "must be between 4 and 18"))
+	}
+	return &HyperLogLog{precision: uint8(precision), registers: make([]uint8, 1<<precision)}
+}
+
+// Add adds an item to the sketch.
+func (h *HyperLogLog) Add(item []byte) {
+	h.addHash(hash64(item))
+}
+
+// AddString adds an item to the sketch, like Add.
+func (h *HyperLogLog) AddString(item string) {
+	h.addHash(hash64(item))
+}
+
+func (h *HyperLogLog) addHash(x uint64) {
+	// the first bits pick a register, which keeps the longest run of leading zeros seen
+	// in the remaining bits
+	index := x >> (64 - h.precision)
+	rank := uint8(bits.LeadingZeros64(x<<h.precision|1<<(h.precision-1))) + 1
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+// Estimate returns the estimated number of distinct items added.
+func (h *HyperLogLog) Estimate() uint64 {
+	m := float64(len(h.registers))
+	sum, zeros := 0.0, 0
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/m)
+	switch m {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	}
+	estimate := alpha * m * m / sum
+	// small cardinalities leave registers empty, and are counted more accurately from them
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// Merge adds every item added to other, as if they had been added to h. other is not
+// modified. Returns an *ArgumentError if the sketches have different precisions.
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if h.precision != other.precision {
+		return newArgumentError("other", "must have the same precision")
+	}
+	for i, r := range other.registers {
+		h.registers[i] = maxOf2(h.registers[i], r)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the sketch, implementing encoding.BinaryMarshaler.
+func (h *HyperLogLog) MarshalBinary() ([]byte, error) {
+	return append([]byte{h.precision}, h.registers...), nil
+}
+
+// UnmarshalBinary decodes a sketch encoded by MarshalBinary, implementing
+// encoding.BinaryUnmarshaler. Returns an *ArgumentError if the data is not a valid encoding.
+func (h *HyperLogLog) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] < 4 || data[0] > 18 || len(data) != 1+1<<data[0] {
+		return newArgumentError("data", "invalid HyperLogLog encoding")
+	}
+	h.precision = data[0]
+	h.registers = append([]uint8(nil), data[1:]...)
+	return nil
+}
+
+// CountMinSketch estimates how many times each item occurs in a stream, in a fixed amount
+// of memory. Estimates are never lower than the true counts, and exceed them by more than
+// e/width times the total count with a probability of at most e^-depth. Sketches filled by
+// different goroutines can be combined with Merge, and saved or sent elsewhere with
+// MarshalBinary. A CountMinSketch is not safe for concurrent use.
+//
+// Example:
+//
+//	hits := gofunc.NewCountMinSketch(2048, 5)
+//	for request := range requests {
+//		hits.AddString(request.Path, 1)
+//	}
+//	fmt.Println(hits.CountString("/login"), "logins")
+type CountMinSketch struct {
+	width, depth int
+	counters     []uint64 // depth rows of width counters
+	total        uint64
+}
+
+// NewCountMinSketch creates an empty CountMinSketch of depth rows of width counters.
+// Panics with an *ArgumentError if width or depth is not positive.
+func NewCountMinSketch(width, depth int) *CountMinSketch {
+	if width <= 0 {
+		panic(newArgumentError("width", "must be positive"))
+	}
+	if depth <= 0 {
+		panic(newArgumentError("depth", "must be positive"))
+	}
+	return &CountMinSketch{width: width, depth: depth, counters: make([]uint64, width*depth)}
+}
+
+// Add adds count occurrences of an item.
+func (s *CountMinSketch) Add(item []byte, count uint64) {
+	s.add(hash64(item), count)
+}
+
+// AddString adds count occurrences of an item, like Add.
+func (s *CountMinSketch) AddString(item string, count uint64) {
+	s.add(hash64(item), count)
+}
+
+// Count returns the estimated number of occurrences of an item.
+func (s *CountMinSketch) Count(item []byte) uint64 {
+	return s.count(hash64(item))
+}
+
+// CountString returns the estimated number of occurrences of an item, like Count.
+func (s *CountMinSketch) CountString(item string) uint64 {
+	return s.count(hash64(item))
+}
+
+// Total returns the total number of occurrences added.
+func (s *CountMinSketch) Total() uint64 {
+	return s.total
+}
+
+func (s *CountMinSketch) add(hash, count uint64) {
+	for row := 0; row < s.depth; row++ {
+		s.counters[s.cell(hash, row)] += count
+	}
+	s.total += count
+}
+
+func (s *CountMinSketch) count(hash uint64) uint64 {
+	result := uint64(math.MaxUint64)
+	for row := 0; row < s.depth; row++ {
+		result = minOf2(result, s.counters[s.cell(hash, row)])
+	}
+	return result
+}
+
+// cell derives the counter of each row from a single hash, with double hashing.
+func (s *CountMinSketch) cell(hash uint64, row int) int {
+	h1, h2 := hash&math.MaxUint32, hash>>32|1
+	return row*s.width + int((h1+uint64(row)*h2)%uint64(s.width))
+}
+
+// Merge adds every occurrence added to other, as if they had been added to s. other is not
+// modified. Returns an *ArgumentError if the sketches have different dimensions.
+func (s *CountMinSketch) Merge(other *CountMinSketch) error {
+	if s.width != other.width || s.depth != other.depth {
+		return newArgumentError("other", "must have the same width and depth")
+	}
+	for i, c := range other.counters {
+		s.counters[i] += c
+	}
+	s.total += other.total
+	return nil
+}
+
+// MarshalBinary encodes the sketch, implementing encoding.BinaryMarshaler.
+func (s *CountMinSketch) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 24+8*len(s.counters))
+	data = binary.BigEndian.AppendUint64(data, uint64(s.width))
+	data = binary.BigEndian.AppendUint64(data, uint64(s.depth))
+	data = binary.BigEndian.AppendUint64(data, s.total)
+	for _, c := range s.counters {
+		data = binary.BigEndian.AppendUint64(data, c)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a sketch encoded by MarshalBinary, implementing
+// encoding.BinaryUnmarshaler. Returns an *ArgumentError if the data is not a valid encoding.
+func (s *CountMinSketch) UnmarshalBinary(data []byte) error {
+	invalid := newArgumentError("data", "invalid CountMinSketch encoding")
+	if len(data) < 24 {
+		return invalid
+	}
+	width, depth := binary.BigEndian.Uint64(data), binary.BigEndian.Uint64(data[8:])
+	data = data[16:]
+	// compare through divisions so that huge dimensions cannot overflow the check
+	cells := uint64(len(data)-8) / 8
+	if width == 0 || depth == 0 || uint64(len(data)-8)%8 != 0 || cells/width != depth || cells%width != 0 {
+		return invalid
+	}
+
+	decoded := &CountMinSketch{width: int(width), depth: int(depth), total: binary.BigEndian.Uint64(data)}
+	decoded.counters = make([]uint64, cells)
+	for i := range decoded.counters {
+		decoded.counters[i] = binary.BigEndian.Uint64(data[8+8*i:])
+	}
+	*s = *decoded
+	return nil
+}
+
+// hash64 hashes an item with 64-bit FNV-1a, followed by the finalizer of MurmurHash3 to
+// spread the bits of similar items. Unlike hash/maphash, the hash does not depend on the
+// process, so that sketches stay comparable once serialized.
+func hash64[T []byte | string](item T) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(item); i++ {
+		h ^= uint64(item[i])
+		h *= 1099511628211
+	}
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
@@ -0,0 +1,115 @@
+package gofunc
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HyperLogLog_Estimate(t *testing.T) {
+	h := NewHyperLogLog(14)
+	assert.Equal(t, uint64(0), h.Estimate())
+
+	h.AddString("a")
+	h.Add([]byte("a"))
+	h.AddString("b")
+	assert.Equal(t, uint64(2), h.Estimate())
+
+	for _, n := range []int{1000, 100000} {
+		h := NewHyperLogLog(14)
+		for i := 0; i < n; i++ {
+			h.AddString(strconv.Itoa(i))
+			h.AddString(strconv.Itoa(i)) // duplicates do not count
+		}
+		assert.InEpsilon(t, n, h.Estimate(), 0.03, "n %d", n)
+	}
+
+	assert.Panics(t, func() { NewHyperLogLog(3) })
+	assert.Panics(t, func() { NewHyperLogLog(19) })
+}
+
+func Test_HyperLogLog_Merge(t *testing.T) {
+	a, b := NewHyperLogLog(12), NewHyperLogLog(12)
+	for i := 0; i < 6000; i++ {
+		a.AddString(strconv.Itoa(i))
+	}
+	for i := 3000; i < 9000; i++ {
+		b.AddString(strconv.Itoa(i))
+	}
+	assert.NoError(t, a.Merge(b))
+	assert.InEpsilon(t, 9000, a.Estimate(), 0.05)
+
+	assert.ErrorIs(t, a.Merge(NewHyperLogLog(10)), ErrInvalidArgument)
+}
+
+func Test_HyperLogLog_MarshalBinary(t *testing.T) {
+	h := NewHyperLogLog(10)
+	for i := 0; i < 500; i++ {
+		h.AddString(strconv.Itoa(i))
+	}
+	data, err := h.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded HyperLogLog
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, h.Estimate(), decoded.Estimate())
+	assert.NoError(t, decoded.Merge(h))
+
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:100]), ErrInvalidArgument)
+	assert.ErrorIs(t, decoded.UnmarshalBinary([]byte{}), ErrInvalidArgument)
+}
+
+func Test_CountMinSketch_Count(t *testing.T) {
+	s := NewCountMinSketch(1000, 5)
+	assert.Equal(t, uint64(0), s.CountString("missing"))
+
+	s.AddString("hot", 1000)
+	s.Add([]byte("hot"), 500)
+	s.AddString("warm", 10)
+	for i := 0; i < 2000; i++ {
+		s.AddString(strconv.Itoa(i), 1)
+	}
+	assert.Equal(t, uint64(3510), s.Total())
+
+	// estimates never undercount, and overcount by at most e/width of the total with
+	// high probability
+	hot := s.Count([]byte("hot"))
+	assert.GreaterOrEqual(t, hot, uint64(1500))
+	assert.LessOrEqual(t, hot, uint64(1500+10))
+	warm := s.CountString("warm")
+	assert.GreaterOrEqual(t, warm, uint64(10))
+	assert.LessOrEqual(t, warm, uint64(10+10))
+
+	assert.Panics(t, func() { NewCountMinSketch(0, 1) })
+	assert.Panics(t, func() { NewCountMinSketch(1, 0) })
+}
+
+func Test_CountMinSketch_Merge(t *testing.T) {
+	a, b := NewCountMinSketch(64, 3), NewCountMinSketch(64, 3)
+	a.AddString("x", 3)
+	b.AddString("x", 4)
+	b.AddString("y", 1)
+	assert.NoError(t, a.Merge(b))
+	assert.GreaterOrEqual(t, a.CountString("x"), uint64(7))
+	assert.Equal(t, uint64(8), a.Total())
+
+	assert.ErrorIs(t, a.Merge(NewCountMinSketch(64, 4)), ErrInvalidArgument)
+	assert.ErrorIs(t, a.Merge(NewCountMinSketch(32, 3)), ErrInvalidArgument)
+}
+
+func Test_CountMinSketch_MarshalBinary(t *testing.T) {
+	s := NewCountMinSketch(16, 2)
+	s.AddString("a", 2)
+	s.AddString("b", 5)
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded CountMinSketch
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, s, &decoded)
+	assert.Equal(t, s.CountString("b"), decoded.CountString("b"))
+
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-8]), ErrInvalidArgument)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:20]), ErrInvalidArgument)
+}
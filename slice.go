@@ -55,6 +55,8 @@ func ToStringDerivedSlice[U, T ~string](slice []T) []U {
 
 // ToNumberSlice converts a slice of number-like types to another numeric type.
 // This is useful when working with custom numeric types that need conversion.
+// Values that do not fit in the target type are silently truncated;
+// use ToNumberSliceChecked to detect that.
 //
 // Example:
 //
@@ -72,7 +74,7 @@ func ToNumberSlice[U, T Number](slice []T) []U {
 
 // ToSet removes duplicate elements from a slice, returning a new slice
 // with unique elements in their first occurrence order.
-// The input slice is not modified.
+// The input slice is not modified; DedupInPlace reuses it instead of allocating.
 //
 // Example:
 //
@@ -147,6 +149,7 @@ func Contains[T comparable](a []T, b T) bool {
 
 // ContainsPred checks if a slice contains an item matching the given predicate.
 // Returns true if any element satisfies the predicate, false otherwise.
+// The predicate is not called after the first match.
 //
 // Example:
 //
@@ -164,6 +167,7 @@ func ContainsPred[T any](a []T, pred func(b T) bool) bool {
 
 // FindPred finds the first item in a slice that matches the given predicate.
 // Returns the found item and true, or zero value and false if not found.
+// The predicate is not called after the first match.
 //
 // Example:
 //
@@ -249,7 +253,8 @@ func IndexOfSlice[T comparable](a []T, sub []T) int {
 
 // ChunkSlice splits a slice into smaller slices of specified size.
 // The last chunk may be smaller if the slice length is not evenly divisible.
-// Returns an empty slice if the input slice is empty.
+// Returns an empty slice if the input slice is empty, whatever the chunk size.
+// Panics if chunkSize is not positive; use TryChunkSlice to get an error instead.
 //
 // Example:
 //
@@ -257,12 +262,26 @@ func IndexOfSlice[T comparable](a []T, sub []T) int {
 //	chunks := gofunc.ChunkSlice(numbers, 3)
 //	// chunks is [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
 func ChunkSlice[T any](slice []T, chunkSize int) [][]T {
+	return Must(TryChunkSlice(slice, chunkSize))
+}
+
+// TryChunkSlice splits a slice into smaller slices of specified size, like ChunkSlice.
+// Returns an *ArgumentError if chunkSize is not positive and the slice is not empty.
+//
+// Example:
+//
+//	_, err := gofunc.TryChunkSlice([]int{1, 2, 3}, 0)
+//	// errors.Is(err, gofunc.ErrInvalidArgument) is true
+func TryChunkSlice[T any](slice []T, chunkSize int) ([][]T, error) {
 	total := len(slice)
 	if total == 0 {
-		return [][]T{}
+		return [][]T{}, nil
+	}
+	if chunkSize <= 0 {
+		return nil, newArgumentError("chunkSize", "must be positive")
 	}
 	if total <= chunkSize {
-		return [][]T{slice}
+		return [][]T{slice}, nil
 	}
 
 	chunks := make([][]T, 0, total/chunkSize+1)
@@ -280,12 +299,13 @@ func ChunkSlice[T any](slice []T, chunkSize int) [][]T {
 		slice = slice[chunkSize:]
 	}
 
-	return chunks
+	return chunks, nil
 }
 
 // ConcatSlices concatenates multiple slices into a single slice.
 // The resulting slice contains all elements from the input slices in order.
 // Returns an empty slice if no slices are provided.
+// A new slice is always allocated; InsertAt inserts into an existing slice instead.
 //
 // Example:
 //
@@ -0,0 +1,157 @@
+package gofunc
+
+// The functions of this file never panic on out-of-range positions: counts are clamped to
+// the length of the slice, and accessors report missing elements with false, like FindPred.
+// The slices they return share the backing array of their input, but with a capacity
+// limited to their length, so that appending to them never overwrites the input.
+
+// Take returns the first n elements of a slice, or the whole slice if it has fewer.
+// A negative n is treated as 0.
+//
+// Example:
+//
+//	top3 := gofunc.Take(ranking, 3)
+func Take[T any](s []T, n int) []T {
+	n = Clamp(n, 0, len(s))
+	return s[:n:n]
+}
+
+// TakeLast returns the last n elements of a slice, or the whole slice if it has fewer.
+// A negative n is treated as 0.
+//
+// Example:
+//
+//	recent := gofunc.TakeLast(events, 10)
+func TakeLast[T any](s []T, n int) []T {
+	return s[len(s)-Clamp(n, 0, len(s)) : len(s) : len(s)]
+}
+
+// TakeWhile returns the leading elements of a slice that match the predicate, stopping at
+// the first element that does not.
+//
+// Example:
+//
+//	small := gofunc.TakeWhile([]int{1, 2, 5, 1}, func(n int) bool { return n < 3 })
+//	// small is []int{1, 2}
+func TakeWhile[T any](s []T, pred func(t T) bool) []T {
+	n := 0
+	for n < len(s) && pred(s[n]) {
+		n++
+	}
+	return s[:n:n]
+}
+
+// Drop returns the elements of a slice after the first n, or an empty slice if it has at
+// most n. A negative n is treated as 0.
+//
+// Example:
+//
+//	rows := gofunc.Drop(lines, 1) // skip the header
+func Drop[T any](s []T, n int) []T {
+	return s[Clamp(n, 0, len(s)):len(s):len(s)]
+}
+
+// DropLast returns the elements of a slice before the last n, or an empty slice if it has
+// at most n. A negative n is treated as 0.
+//
+// Example:
+//
+//	body := gofunc.DropLast(lines, 1) // skip the footer
+func DropLast[T any](s []T, n int) []T {
+	n = len(s) - Clamp(n, 0, len(s))
+	return s[:n:n]
+}
+
+// DropWhile returns the elements of a slice from the first one that does not match the
+// predicate.
+//
+// Example:
+//
+//	rest := gofunc.DropWhile([]int{1, 2, 5, 1}, func(n int) bool { return n < 3 })
+//	// rest is []int{5, 1}
+func DropWhile[T any](s []T, pred func(t T) bool) []T {
+	n := 0
+	for n < len(s) && pred(s[n]) {
+		n++
+	}
+	return s[n:len(s):len(s)]
+}
+
+// First returns the first element of a slice and true, or the zero value and false if the
+// slice is empty.
+//
+// Example:
+//
+//	first, ok := gofunc.First([]string{"a", "b"})
+//	// first is "a", ok is true
+func First[T any](s []T) (T, bool) {
+	return Nth(s, 0)
+}
+
+// Last returns the last element of a slice and true, or the zero value and false if the
+// slice is empty.
+//
+// Example:
+//
+//	last, ok := gofunc.Last([]string{"a", "b"})
+//	// last is "b", ok is true
+func Last[T any](s []T) (T, bool) {
+	return Nth(s, len(s)-1)
+}
+
+// Nth returns the element at index i and true, or the zero value and false if i is out of
+// range, including when it is negative. Use At to count from the end with negative indexes.
+//
+// Example:
+//
+//	third, ok := gofunc.Nth([]int{10, 20}, 2)
+//	// third is 0, ok is false
+func Nth[T any](s []T, i int) (T, bool) {
+	if i < 0 || i >= len(s) {
+		var zeroT T
+		return zeroT, false
+	}
+	return s[i], true
+}
+
+// At returns the element at index i and true, where a negative i counts from the end of
+// the slice: -1 is the last element. Returns the zero value and false if i is out of range.
+//
+// Example:
+//
+//	last, ok := gofunc.At([]int{10, 20, 30}, -1)
+//	// last is 30, ok is true
+func At[T any](s []T, i int) (T, bool) {
+	if i < 0 {
+		i += len(s)
+	}
+	return Nth(s, i)
+}
+
+// SafeSlice returns s[start:end], with start and end clamped to the bounds of the slice
+// instead of panicking. An empty slice is returned if start is not before end.
+//
+// Example:
+//
+//	page := gofunc.SafeSlice(items, offset, offset+limit)
+//	// page holds the items of the page, and is empty past the last page
+func SafeSlice[T any](s []T, start, end int) []T {
+	start = Clamp(start, 0, len(s))
+	end = Clamp(end, start, len(s))
+	return s[start:end:end]
+}
+
+// Reverse returns a new slice with the elements of s in reverse order.
+// The input slice is not modified; ReverseInPlace reverses it instead of allocating.
+//
+// Example:
+//
+//	reversed := gofunc.Reverse([]int{1, 2, 3})
+//	// reversed is []int{3, 2, 1}
+func Reverse[T any](s []T) []T {
+	result := make([]T, len(s))
+	for i, v := range s {
+		result[len(s)-1-i] = v
+	}
+	return result
+}
@@ -0,0 +1,121 @@
+package gofunc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Slice_TakeDrop(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+
+	assert.Equal(t, []int{1, 2}, Take(s, 2))
+	assert.Equal(t, s, Take(s, 10))
+	assert.Equal(t, []int{}, Take(s, 0))
+	assert.Equal(t, []int{}, Take(s, -1))
+	assert.Equal(t, []int{4, 5}, TakeLast(s, 2))
+	assert.Equal(t, s, TakeLast(s, 10))
+	assert.Equal(t, []int{}, TakeLast(s, -1))
+
+	assert.Equal(t, []int{3, 4, 5}, Drop(s, 2))
+	assert.Equal(t, []int{}, Drop(s, 10))
+	assert.Equal(t, s, Drop(s, -1))
+	assert.Equal(t, []int{1, 2, 3}, DropLast(s, 2))
+	assert.Equal(t, []int{}, DropLast(s, 10))
+	assert.Equal(t, s, DropLast(s, -1))
+
+	var empty []int
+	assert.Empty(t, Take(empty, 1))
+	assert.Empty(t, TakeLast(empty, 1))
+	assert.Empty(t, Drop(empty, 1))
+	assert.Empty(t, DropLast(empty, 1))
+
+	// appending to a result never overwrites the input
+	_ = append(Take(s, 2), -1)
+	_ = append(DropLast(s, 2), -1)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s)
+}
+
+func Test_Slice_TakeDropWhile(t *testing.T) {
+	lessThan3 := func(n int) bool { return n < 3 }
+	s := []int{1, 2, 5, 1}
+
+	assert.Equal(t, []int{1, 2}, TakeWhile(s, lessThan3))
+	assert.Equal(t, []int{5, 1}, DropWhile(s, lessThan3))
+	assert.Equal(t, []int{}, TakeWhile([]int{5, 1}, lessThan3))
+	assert.Equal(t, []int{5, 1}, DropWhile([]int{5, 1}, lessThan3))
+	assert.Equal(t, []int{1, 2}, TakeWhile([]int{1, 2}, lessThan3))
+	assert.Equal(t, []int{}, DropWhile([]int{1, 2}, lessThan3))
+
+	_ = append(TakeWhile(s, lessThan3), -1)
+	assert.Equal(t, []int{1, 2, 5, 1}, s)
+}
+
+func Test_Slice_FirstLastNth(t *testing.T) {
+	s := []string{"a", "b", "c"}
+
+	v, ok := First(s)
+	assert.True(t, ok)
+	assert.Equal(t, "a", v)
+	v, ok = Last(s)
+	assert.True(t, ok)
+	assert.Equal(t, "c", v)
+	v, ok = Nth(s, 1)
+	assert.True(t, ok)
+	assert.Equal(t, "b", v)
+
+	_, ok = First([]string{})
+	assert.False(t, ok)
+	_, ok = Last([]string(nil))
+	assert.False(t, ok)
+	v, ok = Nth(s, 3)
+	assert.False(t, ok)
+	assert.Equal(t, "", v)
+	_, ok = Nth(s, -1)
+	assert.False(t, ok)
+}
+
+func Test_Slice_At(t *testing.T) {
+	s := []int{10, 20, 30}
+	tests := []struct {
+		i    int
+		want int
+		ok   bool
+	}{
+		{0, 10, true},
+		{2, 30, true},
+		{3, 0, false},
+		{-1, 30, true},
+		{-3, 10, true},
+		{-4, 0, false},
+	}
+	for _, tt := range tests {
+		v, ok := At(s, tt.i)
+		assert.Equal(t, tt.ok, ok, "i %d", tt.i)
+		assert.Equal(t, tt.want, v, "i %d", tt.i)
+	}
+	_, ok := At([]int{}, -1)
+	assert.False(t, ok)
+}
+
+func Test_Slice_SafeSlice(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, []int{2, 3}, SafeSlice(s, 1, 3))
+	assert.Equal(t, []int{4, 5}, SafeSlice(s, 3, 100))
+	assert.Equal(t, []int{1, 2}, SafeSlice(s, -5, 2))
+	assert.Equal(t, []int{}, SafeSlice(s, 7, 9))
+	assert.Equal(t, []int{}, SafeSlice(s, 3, 1))
+	assert.Equal(t, s, SafeSlice(s, -1, 5))
+	assert.Empty(t, SafeSlice([]int(nil), 0, 1))
+
+	_ = append(SafeSlice(s, 0, 2), -1)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s)
+}
+
+func Test_Slice_Reverse(t *testing.T) {
+	s := []int{1, 2, 3}
+	assert.Equal(t, []int{3, 2, 1}, Reverse(s))
+	assert.Equal(t, []int{1, 2, 3}, s)
+	assert.Equal(t, []string{}, Reverse([]string{}))
+	assert.Equal(t, []string{"a"}, Reverse([]string{"a"}))
+}
@@ -0,0 +1,208 @@
+package gofunc
+
+// The functions of this file modify their input slice instead of allocating a new one.
+// None of them allocates, except DedupInPlace, which keeps the set of the values it has seen.
+// Those that shorten it return the shortened slice, which shares the backing array of the
+// input, and zero the elements left past its end so that they do not keep pointers alive
+// and leak memory. Always use the returned slice, like with append:
+//
+//	items = gofunc.FilterInPlace(items, isValid)
+
+// CompactInPlace replaces runs of consecutive equal elements with a single element,
+// in place, and returns the shortened slice. Sort the slice first to remove all duplicates,
+// or use DedupInPlace to keep the original order.
+//
+// Example:
+//
+//	s := []int{1, 1, 2, 2, 2, 1}
+//	s = gofunc.CompactInPlace(s)
+//	// s is []int{1, 2, 1}
+func CompactInPlace[T comparable](s []T) []T {
+	if len(s) < 2 {
+		return s
+	}
+	n := 1
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[n-1] {
+			s[n] = s[i]
+			n++
+		}
+	}
+	zeroTail(s[n:])
+	return s[:n]
+}
+
+// DedupInPlace removes duplicate elements in place, keeping their first occurrence order,
+// and returns the shortened slice. It is the in-place variant of ToSet, but still allocates
+// a set of up to len(s) seen values. When the order does not matter, sorting the slice then
+// calling CompactInPlace removes the duplicates without allocating.
+//
+// Example:
+//
+//	s := []int{1, 2, 2, 3, 1}
+//	s = gofunc.DedupInPlace(s)
+//	// s is []int{1, 2, 3}
+//
+//	// without allocating, if the order does not matter
+//	sort.Ints(s)
+//	s = gofunc.CompactInPlace(s)
+func DedupInPlace[T comparable](s []T) []T {
+	seen := make(map[T]struct{}, len(s))
+	n := 0
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		s[n] = v
+		n++
+	}
+	zeroTail(s[n:])
+	return s[:n]
+}
+
+// FilterInPlace keeps the elements matching the predicate, in place and in order, and
+// returns the shortened slice.
+//
+// Example:
+//
+//	s := []int{1, 2, 3, 4}
+//	s = gofunc.FilterInPlace(s, func(n int) bool { return n%2 == 0 })
+//	// s is []int{2, 4}
+func FilterInPlace[T any](s []T, pred func(t T) bool) []T {
+	n := 0
+	for _, v := range s {
+		if pred(v) {
+			s[n] = v
+			n++
+		}
+	}
+	zeroTail(s[n:])
+	return s[:n]
+}
+
+// ReverseInPlace reverses the order of the elements, in place, and returns the slice.
+// Reverse returns a reversed copy instead.
+//
+// Example:
+//
+//	s := gofunc.ReverseInPlace([]int{1, 2, 3})
+//	// s is []int{3, 2, 1}
+func ReverseInPlace[T any](s []T) []T {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+	return s
+}
+
+// RotateInPlace rotates the elements k positions to the left, in place, and returns the
+// slice: the element at index k becomes the first one. A negative k rotates to the right,
+// and k can be larger than the length of the slice.
+//
+// Example:
+//
+//	s := gofunc.RotateInPlace([]int{1, 2, 3, 4, 5}, 2)
+//	// s is []int{3, 4, 5, 1, 2}
+func RotateInPlace[T any](s []T, k int) []T {
+	if len(s) == 0 {
+		return s
+	}
+	k %= len(s)
+	if k < 0 {
+		k += len(s)
+	}
+	// reversing both parts, then the whole slice, swaps the parts
+	ReverseInPlace(s[:k])
+	ReverseInPlace(s[k:])
+	return ReverseInPlace(s)
+}
+
+// RemoveAt removes the element at index i, in place, shifting the following elements to
+// the left, and returns the shortened slice.
+// Panics with an *IndexError if i is out of range.
+//
+// Example:
+//
+//	s := []string{"a", "b", "c"}
+//	s = gofunc.RemoveAt(s, 1)
+//	// s is []string{"a", "c"}
+func RemoveAt[T any](s []T, i int) []T {
+	checkIndex(i, len(s))
+	return DeleteRange(s, i, i+1)
+}
+
+// DeleteRange removes the elements s[i:j], in place, shifting the following elements to
+// the left, and returns the shortened slice.
+// Panics with an *IndexError unless 0 <= i <= j <= len(s).
+//
+// Example:
+//
+//	s := []int{0, 1, 2, 3, 4}
+//	s = gofunc.DeleteRange(s, 1, 3)
+//	// s is []int{0, 3, 4}
+func DeleteRange[T any](s []T, i, j int) []T {
+	if i < 0 || i > j {
+		panic(&IndexError{Index: i, Length: len(s)})
+	}
+	if j > len(s) {
+		panic(&IndexError{Index: j, Length: len(s)})
+	}
+	n := copy(s[i:], s[j:])
+	zeroTail(s[i+n:])
+	return s[:i+n]
+}
+
+// InsertAt inserts values before the element at index i, shifting the following elements
+// to the right, and returns the lengthened slice; i can be len(s) to append the values.
+// Like append, it reuses the backing array if its capacity is large enough, and allocates
+// a new one otherwise. The values must not be part of s itself.
+// Panics with an *IndexError if i is out of range.
+//
+// Example:
+//
+//	s := []int{1, 4}
+//	s = gofunc.InsertAt(s, 1, 2, 3)
+//	// s is []int{1, 2, 3, 4}
+func InsertAt[T any](s []T, i int, values ...T) []T {
+	if i < 0 || i > len(s) {
+		panic(&IndexError{Index: i, Length: len(s)})
+	}
+	length := len(s)
+	if length+len(values) > cap(s) {
+		// let append allocate the new backing array, with room to grow
+		s = append(s, values...)
+	} else {
+		s = s[:length+len(values)]
+	}
+	copy(s[i+len(values):], s[i:length])
+	copy(s[i:], values)
+	return s
+}
+
+// Swap swaps the elements at indexes i and j, in place, and returns the slice.
+// Panics with an *IndexError if i or j is out of range.
+//
+// Example:
+//
+//	s := gofunc.Swap([]int{1, 2, 3}, 0, 2)
+//	// s is []int{3, 2, 1}
+func Swap[T any](s []T, i, j int) []T {
+	checkIndex(i, len(s))
+	checkIndex(j, len(s))
+	s[i], s[j] = s[j], s[i]
+	return s
+}
+
+func checkIndex(i, length int) {
+	if i < 0 || i >= length {
+		panic(&IndexError{Index: i, Length: length})
+	}
+}
+
+// zeroTail zeroes the elements of s, so that the backing array does not keep them alive.
+func zeroTail[T any](s []T) {
+	var zeroT T
+	for i := range s {
+		s[i] = zeroT
+	}
+}
@@ -0,0 +1,136 @@
+package gofunc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Slice_CompactInPlace(t *testing.T) {
+	assert.Equal(t, []int{}, CompactInPlace([]int{}))
+	assert.Equal(t, []int{1}, CompactInPlace([]int{1}))
+
+	s := []int{1, 1, 2, 2, 2, 1}
+	result := CompactInPlace(s)
+	assert.Equal(t, []int{1, 2, 1}, result)
+	// the result shares the backing array, and the tail is zeroed
+	assert.Equal(t, &s[0], &result[0])
+	assert.Equal(t, []int{1, 2, 1, 0, 0, 0}, s)
+
+	ptrs := []*int{new(int), new(int)}
+	ptrs[1] = ptrs[0]
+	CompactInPlace(ptrs)
+	assert.Nil(t, ptrs[1])
+}
+
+func Test_Slice_DedupInPlace(t *testing.T) {
+	assert.Equal(t, []string{}, DedupInPlace([]string{}))
+
+	s := []int{1, 2, 2, 3, 1}
+	result := DedupInPlace(s)
+	assert.Equal(t, []int{1, 2, 3}, result)
+	assert.Equal(t, ToSet([]int{1, 2, 2, 3, 1}), result)
+	assert.Equal(t, []int{1, 2, 3, 0, 0}, s)
+}
+
+func Test_Slice_FilterInPlace(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	assert.Equal(t, []int{}, FilterInPlace([]int{}, isEven))
+	assert.Equal(t, []int{}, FilterInPlace([]int{1, 3}, isEven))
+
+	s := []int{1, 2, 3, 4, 6}
+	assert.Equal(t, []int{2, 4, 6}, FilterInPlace(s, isEven))
+	assert.Equal(t, []int{2, 4, 6, 0, 0}, s)
+
+	words := []string{"a", "", "b"}
+	assert.Equal(t, []string{"a", "b"}, FilterInPlace(words, func(w string) bool { return w != "" }))
+	assert.Equal(t, "", words[2])
+}
+
+func Test_Slice_ReverseInPlace(t *testing.T) {
+	assert.Equal(t, []int{}, ReverseInPlace([]int{}))
+	assert.Equal(t, []int{1}, ReverseInPlace([]int{1}))
+	s := []int{1, 2, 3, 4}
+	ReverseInPlace(s)
+	assert.Equal(t, []int{4, 3, 2, 1}, s)
+	assert.Equal(t, []string{"c", "b", "a"}, ReverseInPlace([]string{"a", "b", "c"}))
+}
+
+func Test_Slice_RotateInPlace(t *testing.T) {
+	tests := []struct {
+		k    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{7, []int{3, 4, 5, 1, 2}},
+		{-1, []int{5, 1, 2, 3, 4}},
+		{-6, []int{5, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, RotateInPlace([]int{1, 2, 3, 4, 5}, tt.k), "k %d", tt.k)
+	}
+	assert.Equal(t, []int{}, RotateInPlace([]int{}, 3))
+}
+
+func Test_Slice_RemoveAt(t *testing.T) {
+	s := []string{"a", "b", "c"}
+	assert.Equal(t, []string{"a", "c"}, RemoveAt(s, 1))
+	assert.Equal(t, []string{"a", "c", ""}, s)
+	assert.Equal(t, []int{}, RemoveAt([]int{1}, 0))
+
+	assert.PanicsWithError(t, (&IndexError{Index: 3, Length: 3}).Error(), func() { RemoveAt(s, 3) })
+	_, err := Try(func() []string { return RemoveAt(s, -1) })
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func Test_Slice_DeleteRange(t *testing.T) {
+	s := []int{0, 1, 2, 3, 4}
+	assert.Equal(t, []int{0, 3, 4}, DeleteRange(s, 1, 3))
+	assert.Equal(t, []int{0, 3, 4, 0, 0}, s)
+	assert.Equal(t, []int{1, 2}, DeleteRange([]int{1, 2}, 1, 1))
+	assert.Equal(t, []int{}, DeleteRange([]int{1, 2}, 0, 2))
+	assert.Equal(t, []int{1}, DeleteRange([]int{1, 2}, 1, 2))
+
+	for _, r := range [][2]int{{-1, 1}, {2, 1}, {0, 3}} {
+		_, err := Try(func() []int { return DeleteRange([]int{1, 2}, r[0], r[1]) })
+		assert.ErrorIs(t, err, ErrIndexOutOfRange, "%v", r)
+	}
+}
+
+func Test_Slice_InsertAt(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 4}, InsertAt([]int{1, 4}, 1, 2, 3))
+	assert.Equal(t, []int{0, 1}, InsertAt([]int{1}, 0, 0))
+	assert.Equal(t, []int{1, 2}, InsertAt([]int{1}, 1, 2))
+	assert.Equal(t, []int{1}, InsertAt([]int{}, 0, 1))
+	assert.Equal(t, []int{1}, InsertAt([]int{1}, 0))
+
+	// the backing array is reused when it is large enough
+	s := make([]int, 3, 10)
+	copy(s, []int{1, 2, 5})
+	result := InsertAt(s, 2, 3, 4)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, result)
+	assert.Equal(t, &s[0], &result[0])
+
+	// and left untouched otherwise
+	full := []int{1, 2, 5}
+	result = InsertAt(full, 2, 3, 4)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, result)
+	assert.Equal(t, []int{1, 2, 5}, full)
+
+	_, err := Try(func() []int { return InsertAt([]int{1}, 2, 0) })
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	_, err = Try(func() []int { return InsertAt([]int{1}, -1, 0) })
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func Test_Slice_Swap(t *testing.T) {
+	assert.Equal(t, []int{3, 2, 1}, Swap([]int{1, 2, 3}, 0, 2))
+	assert.Equal(t, []int{1, 2, 3}, Swap([]int{1, 2, 3}, 1, 1))
+
+	_, err := Try(func() []int { return Swap([]int{1, 2, 3}, 0, 3) })
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	_, err = Try(func() []int { return Swap([]int{1, 2, 3}, -1, 0) })
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
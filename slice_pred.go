@@ -0,0 +1,144 @@
+package gofunc
+
+// Every checks if all the items of a slice match the given predicate.
+// Returns true for an empty slice. The predicate is not called after the first item that
+// does not match.
+//
+// Example:
+//
+//	numbers := []int{2, 4, 6}
+//	allEven := gofunc.Every(numbers, func(n int) bool { return n%2 == 0 })
+//	// allEven is true
+func Every[T any](a []T, pred func(b T) bool) bool {
+	for i := range a {
+		if !pred(a[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// All is an alias of Every.
+func All[T any](a []T, pred func(b T) bool) bool {
+	return Every(a, pred)
+}
+
+// NonePred checks if no item of a slice matches the given predicate, the opposite of
+// ContainsPred. Returns true for an empty slice. The predicate is not called after the
+// first match. It is not named None, which creates an empty Option.
+//
+// Example:
+//
+//	numbers := []int{1, 3, 5}
+//	noEven := gofunc.NonePred(numbers, func(n int) bool { return n%2 == 0 })
+//	// noEven is true
+func NonePred[T any](a []T, pred func(b T) bool) bool {
+	return !ContainsPred(a, pred)
+}
+
+// Count returns the number of occurrences of an item in a slice.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 2, 4}
+//	count := gofunc.Count(numbers, 2)
+//	// count is 2
+func Count[T comparable](a []T, t T) int {
+	count := 0
+	for i := range a {
+		if a[i] == t {
+			count++
+		}
+	}
+	return count
+}
+
+// CountPred returns the number of items of a slice matching the given predicate.
+// The predicate is called for every item.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	evens := gofunc.CountPred(numbers, func(n int) bool { return n%2 == 0 })
+//	// evens is 2
+func CountPred[T any](a []T, pred func(b T) bool) int {
+	count := 0
+	for i := range a {
+		if pred(a[i]) {
+			count++
+		}
+	}
+	return count
+}
+
+// FindIndexPred returns the index of the first item of a slice matching the given
+// predicate, or -1 if there is none. The predicate is not called after the first match.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	index := gofunc.FindIndexPred(numbers, func(n int) bool { return n%2 == 0 })
+//	// index is 1
+func FindIndexPred[T any](a []T, pred func(b T) bool) int {
+	for i := range a {
+		if pred(a[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLastPred finds the last item of a slice matching the given predicate, searching from
+// the end. Returns the found item and true, or zero value and false if not found.
+// The predicate is not called on the items before the match.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	even, found := gofunc.FindLastPred(numbers, func(n int) bool { return n%2 == 0 })
+//	// even is 4, found is true
+func FindLastPred[T any](a []T, pred func(b T) bool) (T, bool) {
+	for i := len(a) - 1; i >= 0; i-- {
+		if pred(a[i]) {
+			return a[i], true
+		}
+	}
+	var zeroT T
+	return zeroT, false
+}
+
+// FindAllPred returns all the items of a slice matching the given predicate, in order,
+// in a new slice. The predicate is called for every item.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	evens := gofunc.FindAllPred(numbers, func(n int) bool { return n%2 == 0 })
+//	// evens is []int{2, 4}
+func FindAllPred[T any](a []T, pred func(b T) bool) []T {
+	result := make([]T, 0)
+	for i := range a {
+		if pred(a[i]) {
+			result = append(result, a[i])
+		}
+	}
+	return result
+}
+
+// FindIndexesPred returns the indexes of all the items of a slice matching the given
+// predicate, in ascending order. The predicate is called for every item.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	indexes := gofunc.FindIndexesPred(numbers, func(n int) bool { return n%2 == 0 })
+//	// indexes is []int{1, 3}
+func FindIndexesPred[T any](a []T, pred func(b T) bool) []int {
+	result := make([]int, 0)
+	for i := range a {
+		if pred(a[i]) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
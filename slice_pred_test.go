@@ -0,0 +1,82 @@
+package gofunc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(n int) bool {
+	return n%2 == 0
+}
+
+// countCalls wraps a predicate to count how many times it is called.
+func countCalls(pred func(n int) bool, calls *int) func(n int) bool {
+	return func(n int) bool {
+		*calls++
+		return pred(n)
+	}
+}
+
+func Test_Slice_Every(t *testing.T) {
+	assert.True(t, Every([]int{}, isEven))
+	assert.True(t, Every([]int{2, 4, 6}, isEven))
+	assert.False(t, Every([]int{2, 3, 6}, isEven))
+	assert.True(t, All([]int{2, 4}, isEven))
+	assert.False(t, All([]int{1}, isEven))
+
+	calls := 0
+	Every([]int{2, 3, 4, 6}, countCalls(isEven, &calls))
+	assert.Equal(t, 2, calls)
+}
+
+func Test_Slice_NonePred(t *testing.T) {
+	assert.True(t, NonePred([]int{}, isEven))
+	assert.True(t, NonePred([]int{1, 3, 5}, isEven))
+	assert.False(t, NonePred([]int{1, 2, 5}, isEven))
+
+	calls := 0
+	NonePred([]int{1, 2, 3, 4}, countCalls(isEven, &calls))
+	assert.Equal(t, 2, calls)
+}
+
+func Test_Slice_Count(t *testing.T) {
+	assert.Equal(t, 0, Count([]int{}, 1))
+	assert.Equal(t, 2, Count([]int{1, 2, 3, 2, 4}, 2))
+	assert.Equal(t, 0, Count([]string{"a"}, "b"))
+
+	assert.Equal(t, 0, CountPred([]int{}, isEven))
+	assert.Equal(t, 2, CountPred([]int{1, 2, 3, 4, 5}, isEven))
+}
+
+func Test_Slice_FindIndexPred(t *testing.T) {
+	assert.Equal(t, -1, FindIndexPred([]int{}, isEven))
+	assert.Equal(t, -1, FindIndexPred([]int{1, 3}, isEven))
+	assert.Equal(t, 1, FindIndexPred([]int{1, 2, 3, 4}, isEven))
+
+	calls := 0
+	FindIndexPred([]int{1, 2, 3, 4}, countCalls(isEven, &calls))
+	assert.Equal(t, 2, calls)
+}
+
+func Test_Slice_FindLastPred(t *testing.T) {
+	v, ok := FindLastPred([]int{1, 2, 3, 4, 5}, isEven)
+	assert.True(t, ok)
+	assert.Equal(t, 4, v)
+	v, ok = FindLastPred([]int{1, 3}, isEven)
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	calls := 0
+	FindLastPred([]int{1, 2, 3, 4, 5}, countCalls(isEven, &calls))
+	assert.Equal(t, 2, calls)
+}
+
+func Test_Slice_FindAllPred(t *testing.T) {
+	assert.Equal(t, []int{}, FindAllPred([]int{}, isEven))
+	assert.Equal(t, []int{}, FindAllPred([]int{1, 3}, isEven))
+	assert.Equal(t, []int{2, 4}, FindAllPred([]int{1, 2, 3, 4, 5}, isEven))
+
+	assert.Equal(t, []int{}, FindIndexesPred([]int{}, isEven))
+	assert.Equal(t, []int{1, 3}, FindIndexesPred([]int{1, 2, 3, 4, 5}, isEven))
+}
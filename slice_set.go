@@ -0,0 +1,181 @@
+package gofunc
+
+// Union returns the elements found in a or b, without duplicates, in their first
+// occurrence order: the elements of a come first, then those only found in b.
+// Like ToSet, it returns a new slice and the input slices are not modified.
+//
+// Example:
+//
+//	result := gofunc.Union([]int{1, 2, 2, 3}, []int{3, 4, 1})
+//	// result is []int{1, 2, 3, 4}
+func Union[T comparable](a, b []T) []T {
+	return UnionPred(a, b, func(t T) T { return t })
+}
+
+// UnionPred returns the elements found in a or b like Union, using a key function to
+// determine equality, like ToSetPred. The first element with each key is kept.
+//
+// Example:
+//
+//	type Person struct { Name string; Age int }
+//	everyone := gofunc.UnionPred(team1, team2, func(p Person) string { return p.Name })
+func UnionPred[T any, K comparable](a, b []T, keyFunc func(t T) K) []T {
+	var (
+		seen   = make(map[K]struct{}, len(a)+len(b))
+		result = make([]T, 0, len(a)+len(b))
+	)
+
+	for _, s := range [][]T{a, b} {
+		for _, v := range s {
+			k := keyFunc(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// Intersect returns the elements of a that are also found in b, without duplicates,
+// in their first occurrence order in a.
+//
+// Example:
+//
+//	result := gofunc.Intersect([]int{1, 2, 2, 3}, []int{3, 2, 5})
+//	// result is []int{2, 3}
+func Intersect[T comparable](a, b []T) []T {
+	return IntersectPred(a, b, func(t T) T { return t })
+}
+
+// IntersectPred returns the elements of a whose key is also the key of an element of b,
+// like Intersect, using a key function to determine equality.
+//
+// Example:
+//
+//	type Person struct { Name string; Age int }
+//	inBoth := gofunc.IntersectPred(team1, team2, func(p Person) string { return p.Name })
+func IntersectPred[T any, K comparable](a, b []T, keyFunc func(t T) K) []T {
+	return filterByKeys(a, keysOf(b, keyFunc), keyFunc, true)
+}
+
+// Difference returns the elements of a that are not found in b, without duplicates,
+// in their first occurrence order in a.
+//
+// Example:
+//
+//	result := gofunc.Difference([]int{1, 2, 2, 3}, []int{2})
+//	// result is []int{1, 3}
+func Difference[T comparable](a, b []T) []T {
+	return DifferencePred(a, b, func(t T) T { return t })
+}
+
+// DifferencePred returns the elements of a whose key is not the key of any element of b,
+// like Difference, using a key function to determine equality.
+//
+// Example:
+//
+//	type Person struct { Name string; Age int }
+//	onlyInTeam1 := gofunc.DifferencePred(team1, team2, func(p Person) string { return p.Name })
+func DifferencePred[T any, K comparable](a, b []T, keyFunc func(t T) K) []T {
+	return filterByKeys(a, keysOf(b, keyFunc), keyFunc, false)
+}
+
+// SymmetricDifference returns the elements found in only one of a and b, without
+// duplicates: the elements of a not found in b, then the elements of b not found in a,
+// in their first occurrence order.
+//
+// Example:
+//
+//	result := gofunc.SymmetricDifference([]int{1, 2, 3}, []int{3, 4})
+//	// result is []int{1, 2, 4}
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return SymmetricDifferencePred(a, b, func(t T) T { return t })
+}
+
+// SymmetricDifferencePred returns the elements found in only one of a and b, like
+// SymmetricDifference, using a key function to determine equality.
+//
+// Example:
+//
+//	type Person struct { Name string; Age int }
+//	inOneTeam := gofunc.SymmetricDifferencePred(team1, team2, func(p Person) string { return p.Name })
+func SymmetricDifferencePred[T any, K comparable](a, b []T, keyFunc func(t T) K) []T {
+	onlyA := filterByKeys(a, keysOf(b, keyFunc), keyFunc, false)
+	onlyB := filterByKeys(b, keysOf(a, keyFunc), keyFunc, false)
+	return append(onlyA, onlyB...)
+}
+
+// IsSubsetSlice reports whether every element of sub is also found in super, regardless
+// of order and duplicates. An empty sub is a subset of any slice.
+//
+// Example:
+//
+//	gofunc.IsSubsetSlice([]int{3, 1, 1}, []int{1, 2, 3}) // true
+//	gofunc.IsSubsetSlice([]int{1, 4}, []int{1, 2, 3})    // false
+func IsSubsetSlice[T comparable](sub, super []T) bool {
+	keys := keysOf(super, func(t T) T { return t })
+	for _, v := range sub {
+		if _, ok := keys[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualUnordered reports whether a and b hold the same elements, each the same number of
+// times, in any order. It is handy to compare results whose order does not matter.
+//
+// Example:
+//
+//	gofunc.EqualUnordered([]int{1, 2, 2}, []int{2, 1, 2}) // true
+//	gofunc.EqualUnordered([]int{1, 2, 2}, []int{1, 1, 2}) // false
+func EqualUnordered[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
+
+// keysOf returns the set of the keys of the elements of s.
+func keysOf[T any, K comparable](s []T, keyFunc func(t T) K) map[K]struct{} {
+	keys := make(map[K]struct{}, len(s))
+	for _, v := range s {
+		keys[keyFunc(v)] = struct{}{}
+	}
+	return keys
+}
+
+// filterByKeys returns the elements of s without duplicate keys whose key is in keys if
+// keep is true, or is not in keys if keep is false.
+func filterByKeys[T any, K comparable](s []T, keys map[K]struct{}, keyFunc func(t T) K, keep bool) []T {
+	var (
+		seen   = make(map[K]struct{}, len(s))
+		result = make([]T, 0, len(s))
+	)
+
+	for _, v := range s {
+		k := keyFunc(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		if _, ok := keys[k]; ok == keep {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,71 @@
+package gofunc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Slice_Union(t *testing.T) {
+	assert.Equal(t, []int{}, Union([]int{}, []int{}))
+	assert.Equal(t, []int{1, 2, 3, 4}, Union([]int{1, 2, 2, 3}, []int{3, 4, 1}))
+	assert.Equal(t, []int{3, 4, 1}, Union(nil, []int{3, 4, 1, 4}))
+	assert.Equal(t, []string{"b", "a"}, Union([]string{"b", "a"}, nil))
+
+	a, b := []int{2, 1}, []int{1, 3}
+	Union(a, b)
+	assert.Equal(t, []int{2, 1}, a)
+	assert.Equal(t, []int{1, 3}, b)
+}
+
+func Test_Slice_UnionPred(t *testing.T) {
+	lower := strings.ToLower
+	assert.Equal(t, []string{"Go", "rust", "Zig"}, UnionPred([]string{"Go", "rust"}, []string{"RUST", "go", "Zig"}, lower))
+	assert.Equal(t, []T{{X: 1, Y: "a"}, {X: 2, Y: "c"}},
+		UnionPred([]T{{X: 1, Y: "a"}}, []T{{X: 1, Y: "b"}, {X: 2, Y: "c"}}, func(item T) int64 { return item.X }))
+}
+
+func Test_Slice_Intersect(t *testing.T) {
+	assert.Equal(t, []int{}, Intersect([]int{1, 2}, []int{}))
+	assert.Equal(t, []int{}, Intersect(nil, []int{1}))
+	assert.Equal(t, []int{2, 3}, Intersect([]int{1, 2, 2, 3}, []int{3, 2, 5}))
+	assert.Equal(t, []int{3, 2}, Intersect([]int{3, 2, 1}, []int{1, 2, 3}[1:]))
+
+	assert.Equal(t, []string{"Go"}, IntersectPred([]string{"Go", "rust"}, []string{"GO"}, strings.ToLower))
+}
+
+func Test_Slice_Difference(t *testing.T) {
+	assert.Equal(t, []int{}, Difference([]int{}, []int{1}))
+	assert.Equal(t, []int{1, 3}, Difference([]int{1, 2, 2, 3, 1}, []int{2}))
+	assert.Equal(t, []int{1, 2}, Difference([]int{1, 2}, nil))
+	assert.Equal(t, []int{}, Difference([]int{1, 2}, []int{2, 1}))
+
+	assert.Equal(t, []string{"rust"}, DifferencePred([]string{"Go", "rust", "Rust"}, []string{"GO"}, strings.ToLower))
+}
+
+func Test_Slice_SymmetricDifference(t *testing.T) {
+	assert.Equal(t, []int{}, SymmetricDifference([]int{}, nil))
+	assert.Equal(t, []int{1, 2, 4}, SymmetricDifference([]int{1, 2, 3}, []int{3, 4}))
+	assert.Equal(t, []int{1, 4}, SymmetricDifference([]int{1, 1, 3}, []int{3, 4, 4}))
+	assert.Equal(t, []int{}, SymmetricDifference([]int{1, 2}, []int{2, 1}))
+
+	assert.Equal(t, []string{"rust", "zig"},
+		SymmetricDifferencePred([]string{"Go", "rust"}, []string{"GO", "zig"}, strings.ToLower))
+}
+
+func Test_Slice_IsSubsetSlice(t *testing.T) {
+	assert.True(t, IsSubsetSlice([]int{}, []int{}))
+	assert.True(t, IsSubsetSlice(nil, []int{1}))
+	assert.True(t, IsSubsetSlice([]int{3, 1, 1}, []int{1, 2, 3}))
+	assert.False(t, IsSubsetSlice([]int{1, 4}, []int{1, 2, 3}))
+	assert.False(t, IsSubsetSlice([]string{"a"}, nil))
+}
+
+func Test_Slice_EqualUnordered(t *testing.T) {
+	assert.True(t, EqualUnordered([]int{}, nil))
+	assert.True(t, EqualUnordered([]int{1, 2, 2}, []int{2, 1, 2}))
+	assert.False(t, EqualUnordered([]int{1, 2, 2}, []int{1, 1, 2}))
+	assert.False(t, EqualUnordered([]int{1, 2}, []int{1, 2, 2}))
+	assert.True(t, EqualUnordered([]string{"b", "a"}, []string{"a", "b"}))
+}
@@ -194,6 +194,28 @@ func Test_Slice_ChunkSlice(t *testing.T) {
 	assert.True(t, len(chunkEmpty) == 0)
 }
 
+func Test_Slice_TryChunkSlice(t *testing.T) {
+	chunks, e := TryChunkSlice([]int{1, 2, 3}, 2)
+	assert.NoError(t, e)
+	assert.Equal(t, [][]int{{1, 2}, {3}}, chunks)
+
+	for _, size := range []int{0, -1} {
+		chunks, e = TryChunkSlice([]int{1, 2, 3}, size)
+		assert.Nil(t, chunks)
+		assert.ErrorIs(t, e, ErrInvalidArgument)
+		var argErr *ArgumentError
+		assert.ErrorAs(t, e, &argErr)
+		assert.Equal(t, "chunkSize", argErr.Name)
+	}
+
+	// an empty slice has no chunks whatever the size, as before chunkSize was validated
+	assert.Equal(t, [][]int{}, ChunkSlice([]int{}, 0))
+	chunks, e = TryChunkSlice([]int{}, -1)
+	assert.NoError(t, e)
+	assert.Equal(t, [][]int{}, chunks)
+	assert.Panics(t, func() { ChunkSlice([]int{1}, 0) })
+}
+
 func Test_Slice_ConcatSlices(t *testing.T) {
 	assert.Equal(t, []int{}, ConcatSlices[int](nil, nil, nil))
 	assert.Equal(t, []bool{}, ConcatSlices([]bool{}, []bool{}))
@@ -33,3 +33,18 @@ func SortPred[T any, K Number | ~string](s []T, keyFunc func(t T) K) []T {
 	})
 	return s
 }
+
+// SortFunc sorts a slice in ascending order using a comparator and returns the modified slice.
+// The original slice is modified in place. The comparator returns a negative number if a is
+// less than b, zero if they are equal and a positive number if a is greater, like the
+// Compare methods of time.Time, big.Int or Decimal.
+//
+// Example:
+//
+//	prices := []gofunc.Decimal{gofunc.MustParseDecimal("9.99"), gofunc.MustParseDecimal("4.50")}
+//	gofunc.SortFunc(prices, gofunc.Decimal.Cmp)
+//	// prices is [4.50 9.99]
+func SortFunc[T any](s []T, cmp func(a, b T) int) []T {
+	sort.Slice(s, func(i, j int) bool { return cmp(s[i], s[j]) < 0 })
+	return s
+}
@@ -94,3 +94,10 @@ func Test_Slice_Sorting_Predicate(t *testing.T) {
 		return item.Z
 	}))
 }
+
+func Test_Slice_Sorting_Func(t *testing.T) {
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	assert.Equal(t, []string{"j", "ab", "zzz"}, SortFunc([]string{"zzz", "j", "ab"}, byLength))
+	assert.Equal(t, []int{3, 2, 1}, SortFunc([]int{1, 3, 2}, func(a, b int) int { return b - a }))
+	assert.Equal(t, []int{}, SortFunc([]int{}, compareOrdered[int]))
+}
@@ -0,0 +1,369 @@
+package gofunc
+
+import (
+	"math"
+	"sort"
+)
+
+// Sum returns the sum of the values, or 0 for an empty slice.
+// Floating-point values are added with Klein's second-order variant of Kahan summation,
+// so that rounding errors do not accumulate over long slices, even of float32 values.
+// Integer sums overflow the same way the + operator does.
+//
+// Example:
+//
+//	total := gofunc.Sum([]float64{0.1, 0.2, 0.3})
+//	// total is 0.6, where a naive loop gives 0.6000000000000001
+func Sum[T Number](s []T) T {
+	var sum, cs, ccs T
+	// integer additions are exact, so the compensation is only worth it for floats
+	if !isFloat[T]() {
+		for _, v := range s {
+			sum += v
+		}
+		return sum
+	}
+
+	// sum holds the running total, cs the rounding errors of sum and ccs the rounding
+	// errors of cs
+	for _, v := range s {
+		var t, c T
+		sum, c = twoSum(sum, v)
+		t, c = twoSum(cs, c)
+		cs = t
+		ccs += c
+	}
+	return sum + (cs + ccs)
+}
+
+// twoSum returns a+b rounded, and the rounding error of that addition.
+// The error is 0 when a+b is infinite or NaN: compensating it would compute Inf-Inf, and
+// turn an infinite sum into NaN.
+func twoSum[T Number](a, b T) (T, T) {
+	t := a + b
+	if !isFinite(t) {
+		return t, 0
+	}
+	if absOf(a) >= absOf(b) {
+		return t, (a - t) + b
+	}
+	return t, (b - t) + a
+}
+
+// Mean returns the arithmetic mean of the values.
+// Returns ErrInputRequired if the slice is empty.
+//
+// Example:
+//
+//	mean, err := gofunc.Mean([]int{1, 2, 3, 4})
+//	// mean is 2.5, err is nil
+func Mean[T Number](s []T) (float64, error) {
+	if len(s) == 0 {
+		return 0, ErrInputRequired
+	}
+	var sum kahanSum
+	for _, v := range s {
+		sum.add(float64(v))
+	}
+	return sum.value() / float64(len(s)), nil
+}
+
+// Median returns the middle value of the sorted values, or the mean of the two middle
+// values if their number is even. The input slice is not modified.
+// Returns ErrInputRequired if the slice is empty.
+//
+// Example:
+//
+//	median, err := gofunc.Median([]int{5, 1, 4, 2})
+//	// median is 3, err is nil
+func Median[T Number](s []T) (float64, error) {
+	return Quantile(s, 0.5, QuantileLinear)
+}
+
+// Mode returns the most frequent values in ascending order. Several values are returned
+// when they are equally frequent. Returns ErrInputRequired if the slice is empty.
+//
+// Example:
+//
+//	modes, err := gofunc.Mode([]int{1, 2, 2, 3, 3})
+//	// modes is []int{2, 3}, err is nil
+func Mode[T Number](s []T) ([]T, error) {
+	if len(s) == 0 {
+		return nil, ErrInputRequired
+	}
+	counts := make(map[T]int, len(s))
+	best := 0
+	for _, v := range s {
+		counts[v]++
+		best = maxOf2(best, counts[v])
+	}
+	modes := []T{}
+	for v, count := range counts {
+		if count == best {
+			modes = append(modes, v)
+		}
+	}
+	return Sort(modes), nil
+}
+
+// Variance returns the population variance of the values, computed in a single pass with
+// Welford's algorithm. Use SampleVariance to estimate the variance of a larger population
+// from a sample. Returns ErrInputRequired if the slice is empty.
+//
+// Example:
+//
+//	v, err := gofunc.Variance([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// v is 4, err is nil
+func Variance[T Number](s []T) (float64, error) {
+	if len(s) == 0 {
+		return 0, ErrInputRequired
+	}
+	n, _, m2 := welford(s)
+	return m2 / float64(n), nil
+}
+
+// SampleVariance returns the sample variance of the values, which divides by n-1 instead
+// of n (Bessel's correction). Returns an *ArgumentError if there are fewer than two values.
+//
+// Example:
+//
+//	v, err := gofunc.SampleVariance([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// v is 4.571428571428571, err is nil
+func SampleVariance[T Number](s []T) (float64, error) {
+	if len(s) < 2 {
+		return 0, newArgumentError("s", "at least two values are required")
+	}
+	n, _, m2 := welford(s)
+	return m2 / float64(n-1), nil
+}
+
+// StdDev returns the population standard deviation of the values.
+// Returns ErrInputRequired if the slice is empty.
+//
+// Example:
+//
+//	sd, err := gofunc.StdDev([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// sd is 2, err is nil
+func StdDev[T Number](s []T) (float64, error) {
+	v, err := Variance(s)
+	return math.Sqrt(v), err
+}
+
+// SampleStdDev returns the sample standard deviation of the values.
+// Returns an *ArgumentError if there are fewer than two values.
+func SampleStdDev[T Number](s []T) (float64, error) {
+	v, err := SampleVariance(s)
+	return math.Sqrt(v), err
+}
+
+// QuantileMethod selects how Quantile and Percentile interpolate when the requested
+// position falls between two values. The names follow NumPy's methods of the same name.
+type QuantileMethod int
+
+const (
+	// QuantileLinear interpolates linearly between the two closest values.
+	// It is the default of NumPy, R and spreadsheets.
+	QuantileLinear QuantileMethod = iota
+	// QuantileLower picks the lower of the two closest values.
+	QuantileLower
+	// QuantileHigher picks the higher of the two closest values.
+	QuantileHigher
+	// QuantileNearest picks the closest value, or the one with an even index on ties.
+	QuantileNearest
+	// QuantileMidpoint averages the two closest values.
+	QuantileMidpoint
+)
+
+// Quantile returns the q-quantile of the values, for q between 0 and 1, using the given
+// interpolation method. The input slice is not modified.
+// Returns ErrInputRequired if the slice is empty, or an *ArgumentError if q is out of range
+// or the method is unknown.
+//
+// Example:
+//
+//	q, err := gofunc.Quantile([]int{1, 2, 3, 4}, 0.25, gofunc.QuantileLinear)
+//	// q is 1.75, err is nil
+func Quantile[T Number](s []T, q float64, method QuantileMethod) (float64, error) {
+	if len(s) == 0 {
+		return 0, ErrInputRequired
+	}
+	if !(q >= 0 && q <= 1) {
+		return 0, newArgumentError("q", "must be between 0 and 1")
+	}
+	if method < QuantileLinear || method > QuantileMidpoint {
+		return 0, newArgumentError("method", "unknown quantile method")
+	}
+
+	sorted := make([]float64, len(s))
+	for i, v := range s {
+		sorted[i] = float64(v)
+	}
+	sort.Float64s(sorted)
+
+	h := float64(len(sorted)-1) * q
+	lo, hi := sorted[int(math.Floor(h))], sorted[int(math.Ceil(h))]
+	switch method {
+	case QuantileLower:
+		return lo, nil
+	case QuantileHigher:
+		return hi, nil
+	case QuantileNearest:
+		return sorted[int(math.RoundToEven(h))], nil
+	case QuantileMidpoint:
+		return (lo + hi) / 2, nil
+	default: // QuantileLinear
+		return lo + (h-math.Floor(h))*(hi-lo), nil
+	}
+}
+
+// Percentile returns the p-th percentile of the values, for p between 0 and 100.
+// It is Quantile with p/100.
+//
+// Example:
+//
+//	p95, err := gofunc.Percentile(latencies, 95, gofunc.QuantileLinear)
+func Percentile[T Number](s []T, p float64, method QuantileMethod) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, newArgumentError("p", "must be between 0 and 100")
+	}
+	return Quantile(s, p/100, method)
+}
+
+// Histogram counts the values falling into the buckets delimited by the ascending bounds.
+// The result has len(bounds)+1 counts: result[0] counts the values below bounds[0],
+// result[i] the values in [bounds[i-1], bounds[i]), and the last one the values greater
+// than or equal to the last bound.
+// Returns an *ArgumentError if the bounds are not strictly ascending.
+//
+// Example:
+//
+//	counts, err := gofunc.Histogram([]int{1, 5, 10, 15, 20, 99}, []int{10, 20})
+//	// counts is []int{2, 2, 2}: below 10, in [10, 20), and 20 or more
+func Histogram[T Number](s []T, bounds []T) ([]int, error) {
+	for i := 1; i < len(bounds); i++ {
+		if bounds[i] <= bounds[i-1] {
+			return nil, newArgumentError("bounds", "must be strictly ascending")
+		}
+	}
+	counts := make([]int, len(bounds)+1)
+	for _, v := range s {
+		counts[sort.Search(len(bounds), func(i int) bool { return v < bounds[i] })]++
+	}
+	return counts, nil
+}
+
+// Covariance returns the population covariance of two series of the same length,
+// computed in a single pass. Returns ErrInputRequired if the series are empty, or an
+// *ArgumentError if their lengths differ.
+//
+// Example:
+//
+//	c, err := gofunc.Covariance([]int{1, 2, 3}, []int{1, 2, 3})
+//	// c is 0.6666666666666666, err is nil
+func Covariance[T Number](x, y []T) (float64, error) {
+	n, c, _, _, err := comoments(x, y)
+	if err != nil {
+		return 0, err
+	}
+	return c / float64(n), nil
+}
+
+// SampleCovariance returns the sample covariance of two series of the same length,
+// which divides by n-1 instead of n. Returns ErrInputRequired if the series are empty, or
+// an *ArgumentError if their lengths differ or they hold a single value.
+func SampleCovariance[T Number](x, y []T) (float64, error) {
+	n, c, _, _, err := comoments(x, y)
+	if err != nil {
+		return 0, err
+	}
+	if n < 2 {
+		return 0, newArgumentError("x", "at least two values are required")
+	}
+	return c / float64(n-1), nil
+}
+
+// Correlation returns the Pearson correlation coefficient of two series of the same
+// length, between -1 and 1. Returns ErrInputRequired if the series are empty, or an
+// *ArgumentError if their lengths differ or either series is constant, since the
+// correlation is undefined then.
+//
+// Example:
+//
+//	r, err := gofunc.Correlation([]int{1, 2, 3}, []int{6, 4, 2})
+//	// r is -1, err is nil
+func Correlation[T Number](x, y []T) (float64, error) {
+	_, c, m2x, m2y, err := comoments(x, y)
+	if err != nil {
+		return 0, err
+	}
+	if m2x == 0 || m2y == 0 {
+		return 0, newArgumentError("x", "correlation of a constant series is undefined")
+	}
+	// clamp the rounding errors that could push the result slightly out of [-1, 1]
+	return math.Max(-1, math.Min(1, c/math.Sqrt(m2x*m2y))), nil
+}
+
+// welford returns the number of values, their mean and the sum of squared differences
+// from the mean, updated one value at a time for numerical stability.
+func welford[T Number](s []T) (n int, mean, m2 float64) {
+	for _, v := range s {
+		n++
+		x := float64(v)
+		delta := x - mean
+		mean += delta / float64(n)
+		m2 += delta * (x - mean)
+	}
+	return n, mean, m2
+}
+
+// comoments extends welford to two series, returning the sum of the products of the
+// differences from the means, and the sums of squared differences of each series.
+func comoments[T Number](x, y []T) (n int, c, m2x, m2y float64, err error) {
+	if len(x) != len(y) {
+		return 0, 0, 0, 0, newArgumentError("y", "must have the same length as x")
+	}
+	if len(x) == 0 {
+		return 0, 0, 0, 0, ErrInputRequired
+	}
+	var meanX, meanY float64
+	for i := range x {
+		n++
+		xv, yv := float64(x[i]), float64(y[i])
+		dx := xv - meanX
+		dy := yv - meanY
+		meanX += dx / float64(n)
+		meanY += dy / float64(n)
+		c += dx * (yv - meanY)
+		m2x += dx * (xv - meanX)
+		m2y += dy * (yv - meanY)
+	}
+	return n, c, m2x, m2y, nil
+}
+
+// kahanSum accumulates float64 values the same way Sum does.
+type kahanSum struct {
+	sum, cs, ccs float64
+}
+
+func (k *kahanSum) add(v float64) {
+	var c float64
+	k.sum, c = twoSum(k.sum, v)
+	if !isFinite(k.sum) {
+		// the compensation terms are meaningless once the sum is infinite or NaN
+		k.cs, k.ccs = 0, 0
+		return
+	}
+	k.cs, c = twoSum(k.cs, c)
+	k.ccs += c
+}
+
+func (k *kahanSum) value() float64 {
+	return k.sum + (k.cs + k.ccs)
+}
+
+func absOf[T Number](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,208 @@
+package gofunc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Stats_Sum(t *testing.T) {
+	assert.Equal(t, 0, Sum([]int{}))
+	assert.Equal(t, 10, Sum([]int{1, 2, 3, 4}))
+	assert.Equal(t, uint8(6), Sum([]uint8{1, 2, 3}))
+	assert.Equal(t, 0.6, Sum([]float64{0.1, 0.2, 0.3}))
+
+	// a naive loop loses the small values entirely
+	values := []float64{1, 1e100, 1, -1e100}
+	assert.Equal(t, 2.0, Sum(values))
+
+	many := make([]float32, 1000000)
+	for i := range many {
+		many[i] = 0.1
+	}
+	assert.InDelta(t, 100000, Sum(many), 0.01)
+
+	type Cents int64
+	assert.Equal(t, Cents(300), Sum([]Cents{100, 200}))
+
+	// non-finite sums are not turned into NaN by the compensation
+	assert.Equal(t, math.Inf(1), Sum([]float64{math.Inf(1), 1}))
+	assert.Equal(t, math.Inf(-1), Sum([]float64{1, math.Inf(-1), 2}))
+	assert.Equal(t, math.Inf(1), Sum([]float64{1e308, 1e308}))
+	assert.Equal(t, float32(math.Inf(1)), Sum([]float32{3e38, 3e38}))
+	assert.True(t, math.IsNaN(Sum([]float64{1, math.NaN(), 2})))
+	assert.True(t, math.IsNaN(Sum([]float64{math.Inf(1), math.Inf(-1)})))
+}
+
+func Test_Stats_MeanMedianMode(t *testing.T) {
+	mean, err := Mean([]int{1, 2, 3, 4})
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, mean)
+	_, err = Mean([]float64{})
+	assert.ErrorIs(t, err, ErrInputRequired)
+	mean, err = Mean([]float64{math.Inf(1), 1})
+	assert.NoError(t, err)
+	assert.Equal(t, math.Inf(1), mean)
+	mean, err = Mean([]float64{1e308, 1e308})
+	assert.NoError(t, err)
+	assert.Equal(t, math.Inf(1), mean)
+	mean, err = Mean([]float64{1, math.NaN()})
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(mean))
+
+	median, err := Median([]int{5, 1, 4, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, median)
+	input := []int{3, 1, 2}
+	median, err = Median(input)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, median)
+	assert.Equal(t, []int{3, 1, 2}, input)
+	_, err = Median([]int{})
+	assert.ErrorIs(t, err, ErrInputRequired)
+
+	modes, err := Mode([]int{1, 3, 3, 2, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, modes)
+	floatModes, err := Mode([]float64{1.5})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5}, floatModes)
+	_, err = Mode([]int{})
+	assert.ErrorIs(t, err, ErrInputRequired)
+}
+
+func Test_Stats_Variance(t *testing.T) {
+	values := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	v, err := Variance(values)
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, v)
+	sd, err := StdDev(values)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, sd)
+
+	v, err = SampleVariance(values)
+	assert.NoError(t, err)
+	assert.InDelta(t, 32.0/7, v, 1e-12)
+	sd, err = SampleStdDev(values)
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Sqrt(32.0/7), sd, 1e-12)
+
+	v, err = Variance([]int{42})
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, v)
+
+	_, err = Variance([]int{})
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, err = StdDev([]int{})
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, err = SampleVariance([]int{1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = SampleStdDev([]int{})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	t.Run("numerically stable", func(t *testing.T) {
+		// a large offset ruins the naive sum-of-squares formula
+		shifted := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+		v, err := SampleVariance(shifted)
+		assert.NoError(t, err)
+		assert.InDelta(t, 30.0, v, 1e-6)
+	})
+}
+
+func Test_Stats_Quantile(t *testing.T) {
+	values := []int{4, 1, 3, 2}
+	tests := []struct {
+		method QuantileMethod
+		q      float64
+		want   float64
+	}{
+		{QuantileLinear, 0.25, 1.75},
+		{QuantileLinear, 0, 1},
+		{QuantileLinear, 1, 4},
+		{QuantileLower, 0.25, 1},
+		{QuantileHigher, 0.25, 2},
+		{QuantileNearest, 0.25, 2},
+		{QuantileNearest, 0.5, 3}, // position 1.5 rounds to the even index 2, holding 3
+		{QuantileMidpoint, 0.25, 1.5},
+	}
+	for _, tt := range tests {
+		got, err := Quantile(values, tt.q, tt.method)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got, "method %d, q %v", tt.method, tt.q)
+	}
+	assert.Equal(t, []int{4, 1, 3, 2}, values)
+
+	p, err := Percentile([]float64{10, 20, 30, 40, 50}, 90, QuantileLinear)
+	assert.NoError(t, err)
+	assert.InDelta(t, 46.0, p, 1e-12)
+
+	_, err = Quantile([]int{}, 0.5, QuantileLinear)
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, err = Quantile(values, 1.5, QuantileLinear)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = Quantile(values, math.NaN(), QuantileLinear)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = Percentile(values, -1, QuantileLinear)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	for _, method := range []QuantileMethod{-1, QuantileMidpoint + 1} {
+		_, err = Quantile(values, 0.5, method)
+		var argErr *ArgumentError
+		assert.ErrorAs(t, err, &argErr)
+		assert.Equal(t, "method", argErr.Name)
+	}
+}
+
+func Test_Stats_Histogram(t *testing.T) {
+	counts, err := Histogram([]int{1, 5, 10, 15, 20, 99}, []int{10, 20})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 2, 2}, counts)
+
+	counts, err = Histogram([]float64{0.5, 1.5}, []float64{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2}, counts)
+
+	counts, err = Histogram([]int{}, []int{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 0, 0, 0}, counts)
+
+	_, err = Histogram([]int{1}, []int{2, 2})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func Test_Stats_CovarianceCorrelation(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{2, 4, 5, 4, 5}
+
+	c, err := Covariance(x, y)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.2, c, 1e-12)
+	c, err = SampleCovariance(x, y)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.5, c, 1e-12)
+
+	r, err := Correlation(x, y)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.7745966692414834, r, 1e-12)
+
+	r, err = Correlation([]int{1, 2, 3}, []int{6, 4, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, -1.0, r)
+
+	_, err = Covariance([]int{}, []int{})
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, err = Covariance([]int{1, 2}, []int{1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = SampleCovariance([]int{1}, []int{1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = SampleCovariance([]int{}, []int{})
+	assert.ErrorIs(t, err, ErrInputRequired)
+	// the length mismatch is reported before the number of values
+	_, err = SampleCovariance([]int{1}, []int{1, 2})
+	var argErr *ArgumentError
+	assert.ErrorAs(t, err, &argErr)
+	assert.Equal(t, "y", argErr.Name)
+	_, err = Correlation([]int{1, 1, 1}, []int{1, 2, 3})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
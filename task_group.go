@@ -0,0 +1,260 @@
+package gofunc
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TaskGroupOptions configures a TaskGroup.
+// The zero value runs every task at once and stops at the first error.
+type TaskGroupOptions struct {
+	// Limit is the maximum number of tasks running at the same time. Zero means no limit.
+	Limit int
+	// CollectAllErrors makes the group run every task even if some fail, and report all
+	// their errors combined the same way Combine does. By default, the first error cancels the
+	// context of the other tasks and is the only error reported.
+	CollectAllErrors bool
+}
+
+// TaskGroup runs functions returning a value concurrently and collects their results
+// in submission order. It works like errgroup.Group, with typed results, an optional
+// concurrency limit and an optional collect-all-errors mode.
+// A panic in a task is recovered and reported as a *PanicError.
+//
+// Example:
+//
+//	g, ctx := gofunc.NewTaskGroup[User](ctx, gofunc.TaskGroupOptions{Limit: 8})
+//	for _, id := range ids {
+//		id := id
+//		g.Go(func(ctx context.Context) (User, error) {
+//			return client.GetUser(ctx, id)
+//		})
+//	}
+//	users, err := g.Wait()
+//	// users[i] is the user of ids[i]
+type TaskGroup[T any] struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	sem        chan struct{}
+	collectAll bool
+
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	results []T
+	errs    []error
+}
+
+// NewTaskGroup creates a TaskGroup and the context passed to its tasks.
+// The context is derived from ctx and is canceled by the first failure in fail-fast mode,
+// or once Wait returns in any mode.
+func NewTaskGroup[T any](ctx context.Context, opts TaskGroupOptions) (*TaskGroup[T], context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	g := &TaskGroup[T]{ctx: ctx, cancel: cancel, collectAll: opts.CollectAllErrors}
+	if opts.Limit > 0 {
+		g.sem = make(chan struct{}, opts.Limit)
+	}
+	return g, ctx
+}
+
+// Go starts a task. When the limit is reached, Go blocks until a running task finishes,
+// so tasks start in submission order. A task submitted after the group's context is
+// canceled is not run, and reports the context error.
+func (g *TaskGroup[T]) Go(fn func(ctx context.Context) (T, error)) {
+	g.mu.Lock()
+	index := len(g.results)
+	var zeroT T
+	g.results = append(g.results, zeroT)
+	g.mu.Unlock()
+
+	acquired := false
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+			acquired = true
+		case <-g.ctx.Done():
+		}
+	}
+	// a slot may free up right as a failure cancels the context: do not start the task then
+	if err := g.ctx.Err(); err != nil {
+		if acquired {
+			<-g.sem
+		}
+		g.fail(err)
+		return
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+
+		v, err := callRecover(g.ctx, fn)
+		if err != nil {
+			g.fail(err)
+			return
+		}
+		g.mu.Lock()
+		g.results[index] = v
+		g.mu.Unlock()
+	}()
+}
+
+// Wait blocks until every submitted task is done and returns their results in submission
+// order. Failed tasks leave the zero value in their place. In fail-fast mode the error is
+// the first failure; in collect-all mode it combines every failure, in order of completion,
+// so errors.Is and errors.As match any of them. Returns nil if every task succeeded.
+func (g *TaskGroup[T]) Wait() ([]T, error) {
+	g.wg.Wait()
+	g.cancel()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	results := make([]T, len(g.results))
+	copy(results, g.results)
+	return results, Combine(g.errs...)
+}
+
+func (g *TaskGroup[T]) fail(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.collectAll || len(g.errs) == 0 {
+		g.errs = append(g.errs, err)
+	}
+	if !g.collectAll {
+		g.cancel()
+	}
+}
+
+// Future holds the result of a function running asynchronously.
+// It is created by Async and read with Await or the AwaitAll and AwaitAny helpers.
+type Future[T any] struct {
+	done chan struct{}
+	v    T
+	err  error
+}
+
+// Async starts fn on a new goroutine and returns a Future for its result.
+// A panic in fn is recovered and reported as a *PanicError.
+//
+// Example:
+//
+//	user := gofunc.Async(ctx, func(ctx context.Context) (User, error) {
+//		return client.GetUser(ctx, id)
+//	})
+//	orders := gofunc.Async(ctx, func(ctx context.Context) ([]Order, error) {
+//		return client.GetOrders(ctx, id)
+//	})
+//	u, err := user.Await(ctx)
+func Async[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) *Future[T] {
+	f := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		f.v, f.err = callRecover(ctx, fn)
+	}()
+	return f
+}
+
+// Done returns a channel closed once the result is available.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await blocks until the result is available and returns it.
+// Returns ctx.Err() if the context is done first; the function keeps running.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.v, f.err
+	case <-ctx.Done():
+		var zeroT T
+		return zeroT, ctx.Err()
+	}
+}
+
+// AwaitTimeout blocks until the result is available, for at most timeout.
+// Returns context.DeadlineExceeded if the timeout expires first.
+func (f *Future[T]) AwaitTimeout(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return f.Await(ctx)
+}
+
+// AwaitAll waits for every future and returns their results in order.
+// Failed futures leave the zero value in their place, and their errors are combined
+// the same way Combine does. Returns ctx.Err() if the context is done first.
+//
+// Example:
+//
+//	pages, err := gofunc.AwaitAll(ctx, fetch(1), fetch(2), fetch(3))
+func AwaitAll[T any](ctx context.Context, futures ...*Future[T]) ([]T, error) {
+	results := make([]T, len(futures))
+	errs := make([]error, len(futures))
+	for i, f := range futures {
+		select {
+		case <-f.done:
+			results[i], errs[i] = f.v, f.err
+		case <-ctx.Done():
+			return results, ctx.Err()
+		}
+	}
+	return results, Combine(errs...)
+}
+
+// AwaitAny waits for the first future to succeed and returns its result and index.
+// If every future fails, it returns their errors combined the same way Combine does.
+// Returns ErrInputRequired if no future is given, or ctx.Err() if the context is done first.
+//
+// Example:
+//
+//	// query every replica and keep the fastest answer
+//	v, _, err := gofunc.AwaitAny(ctx, query(replica1), query(replica2))
+func AwaitAny[T any](ctx context.Context, futures ...*Future[T]) (T, int, error) {
+	var zeroT T
+	if len(futures) == 0 {
+		return zeroT, -1, ErrInputRequired
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	completed := make(chan int)
+	for i, f := range futures {
+		go func(i int, f *Future[T]) {
+			select {
+			case <-f.done:
+				select {
+				case completed <- i:
+				case <-stop:
+				}
+			case <-stop:
+			}
+		}(i, f)
+	}
+
+	errs := make([]error, len(futures))
+	for remaining := len(futures); remaining > 0; remaining-- {
+		select {
+		case i := <-completed:
+			if futures[i].err == nil {
+				return futures[i].v, i, nil
+			}
+			errs[i] = futures[i].err
+		case <-ctx.Done():
+			return zeroT, -1, ctx.Err()
+		}
+	}
+	return zeroT, -1, Combine(errs...)
+}
+
+// callRecover calls fn, converting a panic into a *PanicError.
+func callRecover[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) (v T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zeroT T
+			v, err = zeroT, &PanicError{Value: r}
+		}
+	}()
+	return fn(ctx)
+}
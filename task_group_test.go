@@ -0,0 +1,226 @@
+package gofunc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TaskGroup_OrderedResults(t *testing.T) {
+	g, _ := NewTaskGroup[int](context.Background(), TaskGroupOptions{})
+	for i := 0; i < 5; i++ {
+		i := i
+		g.Go(func(ctx context.Context) (int, error) {
+			// later tasks finish first
+			time.Sleep(time.Duration(5-i) * time.Millisecond)
+			return i * i, nil
+		})
+	}
+	results, err := g.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 4, 9, 16}, results)
+
+	empty, _ := NewTaskGroup[string](context.Background(), TaskGroupOptions{})
+	results2, err := empty.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, results2)
+}
+
+func Test_TaskGroup_Limit(t *testing.T) {
+	var running, maxRunning int32
+	g, _ := NewTaskGroup[struct{}](context.Background(), TaskGroupOptions{Limit: 2})
+	for i := 0; i < 10; i++ {
+		g.Go(func(ctx context.Context) (struct{}, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return struct{}{}, nil
+		})
+	}
+	_, err := g.Wait()
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, maxRunning, int32(2))
+}
+
+func Test_TaskGroup_FailFast(t *testing.T) {
+	errFirst := errors.New("first")
+	g, groupCtx := NewTaskGroup[int](context.Background(), TaskGroupOptions{Limit: 1})
+	started := int32(0)
+
+	g.Go(func(ctx context.Context) (int, error) {
+		atomic.AddInt32(&started, 1)
+		return 0, errFirst
+	})
+	for i := 0; i < 5; i++ {
+		g.Go(func(ctx context.Context) (int, error) {
+			atomic.AddInt32(&started, 1)
+			<-ctx.Done()
+			return 1, ctx.Err()
+		})
+	}
+
+	results, err := g.Wait()
+	assert.Equal(t, errFirst, err)
+	assert.Len(t, results, 6)
+	assert.ErrorIs(t, groupCtx.Err(), context.Canceled)
+	// tasks submitted after the failure never ran
+	assert.Equal(t, int32(1), atomic.LoadInt32(&started))
+}
+
+func Test_TaskGroup_CollectAll(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	g, groupCtx := NewTaskGroup[string](context.Background(), TaskGroupOptions{CollectAllErrors: true})
+	g.Go(func(ctx context.Context) (string, error) { return "", errA })
+	g.Go(func(ctx context.Context) (string, error) { return "ok", nil })
+	g.Go(func(ctx context.Context) (string, error) { return "", &ArgumentError{Name: "x", Reason: "bad"} })
+	g.Go(func(ctx context.Context) (string, error) {
+		time.Sleep(5 * time.Millisecond)
+		return "", errB
+	})
+	g.Go(func(ctx context.Context) (string, error) { panic("boom") })
+
+	results, err := g.Wait()
+	assert.Equal(t, []string{"", "ok", "", "", ""}, results)
+	assert.ErrorIs(t, err, errA)
+	assert.ErrorIs(t, err, errB)
+	var argErr *ArgumentError
+	assert.ErrorAs(t, err, &argErr)
+	var panicErr *PanicError
+	assert.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "boom", panicErr.Value)
+
+	var multi *MultiError
+	assert.ErrorAs(t, err, &multi)
+	assert.Equal(t, 4, multi.Len())
+	assert.ErrorIs(t, groupCtx.Err(), context.Canceled)
+}
+
+func Test_TaskGroup_ParentCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, _ := NewTaskGroup[int](ctx, TaskGroupOptions{Limit: 1, CollectAllErrors: true})
+	release := make(chan struct{})
+	g.Go(func(ctx context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+	go func() {
+		time.Sleep(time.Millisecond)
+		cancel()
+	}()
+	// blocks for a slot until the parent context is canceled
+	g.Go(func(ctx context.Context) (int, error) { return 2, nil })
+	close(release)
+
+	results, err := g.Wait()
+	assert.Equal(t, 1, results[0])
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func Test_Future_Await(t *testing.T) {
+	ctx := context.Background()
+	f := Async(ctx, func(ctx context.Context) (string, error) { return "done", nil })
+	<-f.Done()
+	v, err := f.Await(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "done", v)
+
+	release := make(chan struct{})
+	slow := Async(ctx, func(ctx context.Context) (int, error) {
+		<-release
+		return 42, nil
+	})
+	v2, err := slow.AwaitTimeout(5 * time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, v2)
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = slow.Await(canceled)
+	assert.ErrorIs(t, err, context.Canceled)
+	close(release)
+	v2, err = slow.AwaitTimeout(time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, v2)
+
+	panicking := Async(ctx, func(ctx context.Context) (int, error) { panic(errors.New("boom")) })
+	_, err = panicking.Await(ctx)
+	var panicErr *PanicError
+	assert.ErrorAs(t, err, &panicErr)
+	assert.EqualError(t, err, "panic: boom")
+}
+
+func Test_Future_AwaitAll(t *testing.T) {
+	ctx := context.Background()
+	errB := errors.New("b")
+	futures := []*Future[int]{
+		Async(ctx, func(ctx context.Context) (int, error) { return 1, nil }),
+		Async(ctx, func(ctx context.Context) (int, error) { return 0, errB }),
+		Async(ctx, func(ctx context.Context) (int, error) { return 3, nil }),
+	}
+	results, err := AwaitAll(ctx, futures...)
+	assert.Equal(t, []int{1, 0, 3}, results)
+	assert.Equal(t, errB, err)
+
+	results, err = AwaitAll[int](ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, results)
+
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+	never := Async(timeout, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	_, err = AwaitAll(timeout, futures[0], never)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_Future_AwaitAny(t *testing.T) {
+	ctx := context.Background()
+	errA, errB := errors.New("a"), errors.New("b")
+
+	release := make(chan struct{})
+	defer close(release)
+	v, i, err := AwaitAny(ctx,
+		Async(ctx, func(ctx context.Context) (string, error) { return "", errA }),
+		Async(ctx, func(ctx context.Context) (string, error) {
+			<-release
+			return "slow", nil
+		}),
+		Async(ctx, func(ctx context.Context) (string, error) {
+			time.Sleep(time.Millisecond)
+			return "fast", nil
+		}),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "fast", v)
+	assert.Equal(t, 2, i)
+
+	_, i, err = AwaitAny(ctx,
+		Async(ctx, func(ctx context.Context) (string, error) { return "", errA }),
+		Async(ctx, func(ctx context.Context) (string, error) { return "", errB }),
+	)
+	assert.Equal(t, -1, i)
+	assert.ErrorIs(t, err, errA)
+	assert.ErrorIs(t, err, errB)
+
+	_, _, err = AwaitAny[int](ctx)
+	assert.ErrorIs(t, err, ErrInputRequired)
+
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+	_, _, err = AwaitAny(timeout, Async(ctx, func(ctx context.Context) (string, error) {
+		<-release
+		return "", nil
+	}))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
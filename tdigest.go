@@ -0,0 +1,262 @@
+package gofunc
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+)
+
+// TDigest estimates quantiles of a stream of values in bounded memory.
+// It summarizes the values into clusters that are small near the extremes and larger
+// around the median, so that tail quantiles such as the 99th percentile stay accurate.
+// Digests filled by different goroutines can be combined with Merge, and saved or sent
+// elsewhere with MarshalBinary. The zero value is an empty digest with a compression of
+// 100. A TDigest is not safe for concurrent use.
+//
+// Example:
+//
+//	digest := gofunc.NewTDigest(100)
+//	for latency := range latencies {
+//		digest.Add(latency.Seconds())
+//	}
+//	p99, _ := digest.Quantile(0.99)
+type TDigest struct {
+	compression float64
+	centroids   []centroid // sorted by mean
+	buffer      []centroid // values added since the last compression
+	count       float64
+	min, max    float64
+}
+
+// defaultTDigestCompression is the compression of the zero value of TDigest.
+const defaultTDigestCompression = 100
+
+type centroid struct {
+	mean, weight float64
+}
+
+// NewTDigest creates an empty TDigest. Higher compressions keep more clusters, trading
+// memory for accuracy: a digest holds at most about compression clusters, and 100 is a
+// common choice. Panics with an *ArgumentError if compression is less than 1.
+func NewTDigest(compression float64) *TDigest {
+	if !(compression >= 1) {
+		panic(newArgumentError("compression", "must be at least 1"))
+	}
+	return &TDigest{compression: compression, min: math.Inf(1), max: math.Inf(-1)}
+}
+
+// Add adds values to the digest. NaN values are ignored.
+func (d *TDigest) Add(values ...float64) {
+	d.lazyInit()
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		d.addCentroid(centroid{mean: v, weight: 1})
+		d.min = math.Min(d.min, v)
+		d.max = math.Max(d.max, v)
+	}
+}
+
+// Merge adds every value summarized by other, as if they had been added to d.
+// other is not modified.
+func (d *TDigest) Merge(other *TDigest) {
+	d.lazyInit()
+	if other.count == 0 {
+		// an empty digest may be a zero value, whose extremes are not set
+		return
+	}
+	for _, cs := range [][]centroid{other.centroids, other.buffer} {
+		for _, c := range cs {
+			d.addCentroid(c)
+		}
+	}
+	d.min = math.Min(d.min, other.min)
+	d.max = math.Max(d.max, other.max)
+}
+
+// Count returns the number of values added.
+func (d *TDigest) Count() int {
+	return int(d.count)
+}
+
+// Quantile returns an estimate of the q-quantile of the values, for q between 0 and 1.
+// Returns ErrInputRequired if no value was added, or an *ArgumentError if q is out of range.
+func (d *TDigest) Quantile(q float64) (float64, error) {
+	if d.count == 0 {
+		return 0, ErrInputRequired
+	}
+	if !(q >= 0 && q <= 1) {
+		return 0, newArgumentError("q", "must be between 0 and 1")
+	}
+	d.compress()
+
+	// the extremes are tracked exactly
+	switch q {
+	case 0:
+		return d.min, nil
+	case 1:
+		return d.max, nil
+	}
+	cs := d.centroids
+	if len(cs) == 1 {
+		return cs[0].mean, nil
+	}
+
+	// each centroid is assumed to be centered on its mean: interpolate between the
+	// centers surrounding the requested rank, and between the extremes at both ends
+	rank := q * d.count
+	if first := cs[0].weight / 2; rank < first {
+		return d.min + (cs[0].mean-d.min)*rank/first, nil
+	}
+	cumulative := 0.0
+	for i := 0; i < len(cs)-1; i++ {
+		left := cumulative + cs[i].weight/2
+		right := cumulative + cs[i].weight + cs[i+1].weight/2
+		if rank < right {
+			return cs[i].mean + (cs[i+1].mean-cs[i].mean)*(rank-left)/(right-left), nil
+		}
+		cumulative += cs[i].weight
+	}
+	last := cs[len(cs)-1]
+	left := d.count - last.weight/2
+	return last.mean + (d.max-last.mean)*(rank-left)/(d.count-left), nil
+}
+
+// CDF returns an estimate of the fraction of values less than or equal to x.
+// Returns ErrInputRequired if no value was added.
+func (d *TDigest) CDF(x float64) (float64, error) {
+	if d.count == 0 {
+		return 0, ErrInputRequired
+	}
+	d.compress()
+
+	switch {
+	case x < d.min:
+		return 0, nil
+	case x >= d.max:
+		return 1, nil
+	}
+	cs := d.centroids
+	if len(cs) == 1 {
+		return (x - d.min) / (d.max - d.min), nil
+	}
+	if x < cs[0].mean {
+		return cs[0].weight / 2 * (x - d.min) / (cs[0].mean - d.min) / d.count, nil
+	}
+	cumulative := 0.0
+	for i := 0; i < len(cs)-1; i++ {
+		if x < cs[i+1].mean {
+			left := cumulative + cs[i].weight/2
+			right := cumulative + cs[i].weight + cs[i+1].weight/2
+			return (left + (right-left)*(x-cs[i].mean)/(cs[i+1].mean-cs[i].mean)) / d.count, nil
+		}
+		cumulative += cs[i].weight
+	}
+	last := cs[len(cs)-1]
+	left := d.count - last.weight/2
+	return (left + last.weight/2*(x-last.mean)/(d.max-last.mean)) / d.count, nil
+}
+
+// MarshalBinary encodes the digest, implementing encoding.BinaryMarshaler.
+func (d *TDigest) MarshalBinary() ([]byte, error) {
+	d.lazyInit()
+	d.compress()
+	data := make([]byte, 0, 32+16*len(d.centroids))
+	for _, f := range []float64{d.compression, d.min, d.max, float64(len(d.centroids))} {
+		data = binary.BigEndian.AppendUint64(data, math.Float64bits(f))
+	}
+	for _, c := range d.centroids {
+		data = binary.BigEndian.AppendUint64(data, math.Float64bits(c.mean))
+		data = binary.BigEndian.AppendUint64(data, math.Float64bits(c.weight))
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a digest encoded by MarshalBinary, implementing
+// encoding.BinaryUnmarshaler. Returns an *ArgumentError if the data is not a valid encoding.
+func (d *TDigest) UnmarshalBinary(data []byte) error {
+	invalid := newArgumentError("data", "invalid TDigest encoding")
+	if len(data) < 32 {
+		return invalid
+	}
+	read := func() float64 {
+		f := math.Float64frombits(binary.BigEndian.Uint64(data))
+		data = data[8:]
+		return f
+	}
+	compression, min, max, n := read(), read(), read(), read()
+	if !(compression >= 1) || n < 0 || n != math.Trunc(n) || float64(len(data)) != 16*n {
+		return invalid
+	}
+
+	decoded := &TDigest{compression: compression, min: min, max: max, centroids: make([]centroid, int(n))}
+	for i := range decoded.centroids {
+		c := centroid{mean: read(), weight: read()}
+		// the comparisons are written to fail on NaN
+		if !(c.weight > 0) || math.IsInf(c.weight, 1) || !(c.mean >= min && c.mean <= max) ||
+			(i > 0 && c.mean < decoded.centroids[i-1].mean) {
+			return invalid
+		}
+		decoded.centroids[i] = c
+		decoded.count += c.weight
+	}
+	*d = *decoded
+	return nil
+}
+
+// lazyInit makes the zero value an empty digest with the default compression.
+func (d *TDigest) lazyInit() {
+	if d.compression == 0 {
+		*d = TDigest{compression: defaultTDigestCompression, min: math.Inf(1), max: math.Inf(-1)}
+	}
+}
+
+func (d *TDigest) addCentroid(c centroid) {
+	d.buffer = append(d.buffer, c)
+	d.count += c.weight
+	if len(d.buffer) >= int(5*d.compression) {
+		d.compress()
+	}
+}
+
+// compress merges the buffered values into the clusters, following the merging variant of
+// the t-digest: adjacent clusters are combined as long as they span at most one unit of
+// the scale function k(q) = compression/(2π) * asin(2q-1), which is steep near the
+// extremes and flat around the median.
+func (d *TDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := append(d.centroids, d.buffer...)
+	d.buffer = d.buffer[:0]
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	merged := make([]centroid, 0, int(d.compression)+1)
+	merged = append(merged, all[0])
+	before := 0.0 // weight of the clusters before the last merged one
+	limit := d.count * d.scaleInverse(d.scale(0)+1)
+	for _, c := range all[1:] {
+		last := &merged[len(merged)-1]
+		if before+last.weight+c.weight <= limit {
+			last.weight += c.weight
+			last.mean += (c.mean - last.mean) * c.weight / last.weight
+			continue
+		}
+		before += last.weight
+		limit = d.count * d.scaleInverse(d.scale(before/d.count)+1)
+		merged = append(merged, c)
+	}
+	d.centroids = merged
+}
+
+func (d *TDigest) scale(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+func (d *TDigest) scaleInverse(k float64) float64 {
+	if k >= d.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/d.compression) + 1) / 2
+}
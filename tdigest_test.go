@@ -0,0 +1,193 @@
+package gofunc
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TDigest_Quantile(t *testing.T) {
+	d := NewTDigest(100)
+	_, err := d.Quantile(0.5)
+	assert.ErrorIs(t, err, ErrInputRequired)
+	_, err = d.CDF(0)
+	assert.ErrorIs(t, err, ErrInputRequired)
+
+	r := rand.New(rand.NewSource(1))
+	values := make([]float64, 100000)
+	for i := range values {
+		values[i] = r.NormFloat64()
+	}
+	d.Add(values...)
+	d.Add(math.NaN())
+	assert.Equal(t, len(values), d.Count())
+	assert.LessOrEqual(t, len(d.centroids), 100)
+
+	sort.Float64s(values)
+	for _, q := range []float64{0.001, 0.01, 0.25, 0.5, 0.75, 0.99, 0.999} {
+		got, err := d.Quantile(q)
+		assert.NoError(t, err)
+		// the error is measured in rank, and is smaller in the tails
+		rank := float64(sort.SearchFloat64s(values, got)) / float64(len(values))
+		assert.InDelta(t, q, rank, If(q < 0.01 || q > 0.99, 0.0005, 0.002), "q %v", q)
+
+		want := values[int(q*float64(len(values)))]
+
+		cdf, err := d.CDF(want)
+		assert.NoError(t, err)
+		assert.InDelta(t, q, cdf, 0.005, "cdf of the %v quantile", q)
+	}
+
+	min, _ := d.Quantile(0)
+	max, _ := d.Quantile(1)
+	assert.Equal(t, values[0], min)
+	assert.Equal(t, values[len(values)-1], max)
+	cdf, _ := d.CDF(values[0] - 1)
+	assert.Equal(t, 0.0, cdf)
+	cdf, _ = d.CDF(values[len(values)-1])
+	assert.Equal(t, 1.0, cdf)
+
+	_, err = d.Quantile(1.5)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func Test_TDigest_SmallInputs(t *testing.T) {
+	d := NewTDigest(100)
+	d.Add(5)
+	q, err := d.Quantile(0.5)
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, q)
+
+	d.Add(1, 2, 3, 4)
+	median, err := d.Quantile(0.5)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, median)
+
+	assert.Panics(t, func() { NewTDigest(0) })
+
+	// a single centroid still reports the exact extremes
+	single := NewTDigest(1)
+	single.Add(1, 2, 3, 4, 5)
+	single.compress()
+	assert.Len(t, single.centroids, 1)
+	min, err := single.Quantile(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, min)
+	max, err := single.Quantile(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, max)
+	mid, err := single.Quantile(0.5)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, mid)
+}
+
+func Test_TDigest_Merge(t *testing.T) {
+	whole, merged := NewTDigest(100), NewTDigest(100)
+	parts := []*TDigest{NewTDigest(100), NewTDigest(100), NewTDigest(100)}
+	for i := 0; i < 30000; i++ {
+		whole.Add(float64(i))
+		parts[i%3].Add(float64(i))
+	}
+	for _, p := range parts {
+		merged.Merge(p)
+	}
+	assert.Equal(t, whole.Count(), merged.Count())
+	for _, q := range []float64{0, 0.01, 0.5, 0.99, 1} {
+		want, _ := whole.Quantile(q)
+		got, _ := merged.Quantile(q)
+		assert.InDelta(t, want, got, 0.002*30000, "q %v", q)
+	}
+	assert.Equal(t, 10000, parts[0].Count())
+}
+
+func Test_TDigest_MarshalBinary(t *testing.T) {
+	d := NewTDigest(50)
+	for i := 0; i < 1000; i++ {
+		d.Add(float64(i % 97))
+	}
+	data, err := d.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded TDigest
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, d.Count(), decoded.Count())
+	for _, q := range []float64{0, 0.3, 0.9, 1} {
+		want, _ := d.Quantile(q)
+		got, _ := decoded.Quantile(q)
+		assert.Equal(t, want, got)
+	}
+	decoded.Add(1000)
+	max, _ := decoded.Quantile(1)
+	assert.Equal(t, 1000.0, max)
+
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-1]), ErrInvalidArgument)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(nil), ErrInvalidArgument)
+
+	// a non-integer number of centroids, with a length that matches it
+	fractional := append([]byte(nil), data[:24]...)
+	fractional = binary.BigEndian.AppendUint64(fractional, math.Float64bits(0.5))
+	fractional = append(fractional, make([]byte, 8)...)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(fractional), ErrInvalidArgument)
+
+	// corrupt centroids are rejected
+	encode := func(min, max float64, centroids ...float64) []byte {
+		data := []byte{}
+		for _, f := range append([]float64{50, min, max, float64(len(centroids) / 2)}, centroids...) {
+			data = binary.BigEndian.AppendUint64(data, math.Float64bits(f))
+		}
+		return data
+	}
+	assert.NoError(t, decoded.UnmarshalBinary(encode(1, 3, 1, 2, 3, 1)))
+	assert.Equal(t, 3, decoded.Count())
+	for _, data := range [][]byte{
+		encode(1, 3, 1, math.NaN(), 3, 1),
+		encode(1, 3, 1, -1, 3, 2),
+		encode(1, 3, 1, 0, 3, 1),
+		encode(1, 3, 1, math.Inf(1), 3, 1),
+		encode(1, 3, 3, 1, 1, 1),
+		encode(1, 3, math.NaN(), 1, 3, 1),
+		encode(2, 3, 1, 1, 3, 1),
+		encode(1, 2, 1, 1, 3, 1),
+		encode(3, 1, 1, 1, 3, 1),
+	} {
+		assert.ErrorIs(t, decoded.UnmarshalBinary(data), ErrInvalidArgument)
+	}
+	// the digest is left unchanged by an invalid encoding
+	assert.Equal(t, 3, decoded.Count())
+}
+
+func Test_TDigest_ZeroValue(t *testing.T) {
+	var d TDigest
+	_, err := d.Quantile(0.5)
+	assert.ErrorIs(t, err, ErrInputRequired)
+
+	for i := 5; i <= 1004; i++ {
+		d.Add(float64(i))
+	}
+	min, _ := d.Quantile(0)
+	max, _ := d.Quantile(1)
+	assert.Equal(t, 5.0, min)
+	assert.Equal(t, 1004.0, max)
+	median, _ := d.Quantile(0.5)
+	assert.InDelta(t, 504.5, median, 1)
+	d.compress()
+	assert.Greater(t, len(d.centroids), 10)
+
+	// merging an empty zero value changes nothing, and merging into one initializes it
+	var empty, merged TDigest
+	d.Merge(&empty)
+	assert.Equal(t, 1000, d.Count())
+	merged.Merge(&d)
+	min, _ = merged.Quantile(0)
+	assert.Equal(t, 5.0, min)
+
+	data, err := empty.MarshalBinary()
+	assert.NoError(t, err)
+	var decoded TDigest
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, 0, decoded.Count())
+}
@@ -0,0 +1,235 @@
+package gofunc
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Debounce returns a function that delays calling fn until wait has passed without
+// another call, then calls it with the argument of the last call. It is useful to react
+// once to a burst of events, such as saving a document after the user stops typing.
+// fn runs on its own goroutine. The returned cancel function drops a pending call.
+//
+// Example:
+//
+//	save, cancel := gofunc.Debounce(500*time.Millisecond, func(doc string) {
+//		store.Save(doc)
+//	})
+//	defer cancel()
+//	save("h")
+//	save("hi") // only "hi" is saved, 500ms after this call
+func Debounce[T any](wait time.Duration, fn func(T)) (debounced func(T), cancel func()) {
+	var (
+		mu    sync.Mutex
+		timer *time.Timer
+	)
+	debounced = func(arg T) {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(wait, func() { fn(arg) })
+	}
+	cancel = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+	return debounced, cancel
+}
+
+// Throttle returns a function that calls fn at most once per interval.
+// The first call goes through immediately, and calls made before the interval has
+// elapsed are dropped. The returned function reports whether fn was called.
+//
+// Example:
+//
+//	report := gofunc.Throttle(time.Second, func(p Progress) {
+//		fmt.Printf("%d%%\n", p.Percent)
+//	})
+//	for p := range progress {
+//		report(p) // prints at most once per second
+//	}
+func Throttle[T any](interval time.Duration, fn func(T)) func(T) bool {
+	return throttle(interval, fn, SystemClock())
+}
+
+func throttle[T any](interval time.Duration, fn func(T), clock Clock) func(T) bool {
+	var (
+		mu   sync.Mutex
+		next time.Time
+	)
+	return func(arg T) bool {
+		mu.Lock()
+		now := clock.Now()
+		if now.Before(next) {
+			mu.Unlock()
+			return false
+		}
+		next = now.Add(interval)
+		mu.Unlock()
+
+		fn(arg)
+		return true
+	}
+}
+
+// Coalescer collects items added over a time window and hands them to a flush callback in
+// batches, trading a little latency for fewer, larger operations such as bulk inserts.
+// A window starts with the first item added after a flush. When it ends, the pending items
+// are flushed in chunks of at most the maximum batch size, like ChunkSlice would split them.
+// A batch is also flushed as soon as it is full. Flushes never overlap, and batches are
+// flushed in the order their items were added, by one goroutine at a time: Add and Pending
+// never wait for a flush made by another goroutine. The flush callback may call Add and
+// Pending, but not Flush or Close. A Coalescer is safe for concurrent use.
+//
+// Example:
+//
+//	c := gofunc.NewCoalescer(100*time.Millisecond, 500, func(events []Event) {
+//		db.InsertEvents(events)
+//	})
+//	defer c.Close()
+//	c.Add(event)
+type Coalescer[T any] struct {
+	window       time.Duration
+	maxBatchSize int
+	flush        func(batch []T)
+
+	mu       sync.Mutex
+	flushed  *sync.Cond // broadcast on mu whenever a batch is flushed
+	pending  []T
+	queue    [][]T // batches waiting to be flushed, in order
+	queued   int   // number of batches ever queued
+	done     int   // number of batches ever flushed
+	flushing bool  // whether a goroutine is draining the queue
+	timer    *time.Timer
+	closed   bool
+}
+
+// NewCoalescer creates a Coalescer flushing items window after the first one is added,
+// in batches of at most maxBatchSize items. A maxBatchSize of zero means no limit.
+// Panics with an *ArgumentError if window is not positive or maxBatchSize is negative.
+func NewCoalescer[T any](window time.Duration, maxBatchSize int, flush func(batch []T)) *Coalescer[T] {
+	if window <= 0 {
+		panic(newArgumentError("window", "must be positive"))
+	}
+	if maxBatchSize < 0 {
+		panic(newArgumentError("maxBatchSize", "must not be negative"))
+	}
+	c := &Coalescer[T]{window: window, maxBatchSize: maxBatchSize, flush: flush}
+	c.flushed = sync.NewCond(&c.mu)
+	return c
+}
+
+// Add queues items for the next flush. Full batches are flushed before Add returns, unless
+// another goroutine is flushing: that goroutine then flushes them after its own batches.
+// Panics with an error matching ErrClosed if the Coalescer is closed.
+func (c *Coalescer[T]) Add(items ...T) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		panic(fmt.Errorf("gofunc: Add called on a closed Coalescer: %w", ErrClosed))
+	}
+	c.pending = append(c.pending, items...)
+
+	if c.maxBatchSize > 0 && len(c.pending) >= c.maxBatchSize {
+		// keep the remainder pending, so that full batches do not wait for the window
+		n := len(c.pending) / c.maxBatchSize * c.maxBatchSize
+		c.enqueue(c.pending[:n:n])
+		c.pending = append([]T(nil), c.pending[n:]...)
+	}
+	if len(c.pending) > 0 && c.timer == nil {
+		c.timer = time.AfterFunc(c.window, c.Flush)
+	} else if len(c.pending) == 0 {
+		c.stopTimer()
+	}
+	c.drain(false)
+}
+
+// Flush immediately flushes every pending item, and returns once they are flushed.
+func (c *Coalescer[T]) Flush() {
+	c.mu.Lock()
+	c.enqueue(c.pending)
+	c.pending = nil
+	c.stopTimer()
+	c.drain(true)
+}
+
+// Close flushes every pending item and stops the Coalescer.
+// Adding items after Close panics; calling Close again does nothing.
+func (c *Coalescer[T]) Close() {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+	c.Flush()
+}
+
+// Pending returns the number of items waiting to be flushed.
+func (c *Coalescer[T]) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.pending)
+}
+
+func (c *Coalescer[T]) stopTimer() {
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+}
+
+// enqueue splits items into batches and queues them. mu must be locked.
+func (c *Coalescer[T]) enqueue(items []T) {
+	if len(items) == 0 {
+		return
+	}
+	batches := [][]T{items}
+	if c.maxBatchSize > 0 {
+		batches = ChunkSlice(items, c.maxBatchSize)
+	}
+	c.queue = append(c.queue, batches...)
+	c.queued += len(batches)
+}
+
+// drain flushes the queued batches in order, and unlocks mu, which must be locked by the
+// caller. Only one goroutine drains the queue at a time: if another one is, drain leaves
+// the batches to it, and waits until they are flushed if wait is true.
+func (c *Coalescer[T]) drain(wait bool) {
+	target := c.queued
+	for c.flushing {
+		if !wait || c.done >= target {
+			c.mu.Unlock()
+			return
+		}
+		// if the flushing goroutine stops early because flush panicked, take over
+		c.flushed.Wait()
+	}
+
+	c.flushing = true
+	defer func() {
+		// also runs if flush panics, so that the next caller takes over
+		c.flushing = false
+		c.mu.Unlock()
+	}()
+	for len(c.queue) > 0 {
+		batch := c.queue[0]
+		c.queue[0] = nil
+		c.queue = c.queue[1:]
+		c.flushUnlocked(batch)
+	}
+}
+
+// flushUnlocked calls flush without holding mu.
+func (c *Coalescer[T]) flushUnlocked(batch []T) {
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.done++
+		c.flushed.Broadcast()
+	}()
+	c.flush(batch)
+}
@@ -0,0 +1,170 @@
+package gofunc
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Throttle_Debounce(t *testing.T) {
+	calls := make(chan string, 10)
+	debounced, cancel := Debounce(20*time.Millisecond, func(s string) { calls <- s })
+	defer cancel()
+
+	debounced("a")
+	debounced("ab")
+	debounced("abc")
+	assert.Equal(t, "abc", <-calls)
+
+	select {
+	case s := <-calls:
+		t.Fatalf("unexpected call with %q", s)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	debounced("dropped")
+	cancel()
+	select {
+	case s := <-calls:
+		t.Fatalf("unexpected call with %q", s)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func Test_Throttle_Throttle(t *testing.T) {
+	clock := newFakeClock()
+	got := []int{}
+	throttled := throttle(time.Second, func(i int) { got = append(got, i) }, clock)
+
+	assert.True(t, throttled(1))
+	assert.False(t, throttled(2))
+	clock.Advance(999 * time.Millisecond)
+	assert.False(t, throttled(3))
+	clock.Advance(time.Millisecond)
+	assert.True(t, throttled(4))
+	assert.Equal(t, []int{1, 4}, got)
+
+	calls := 0
+	withSystemClock := Throttle(time.Hour, func(struct{}) { calls++ })
+	withSystemClock(struct{}{})
+	withSystemClock(struct{}{})
+	assert.Equal(t, 1, calls)
+}
+
+func Test_Throttle_Coalescer(t *testing.T) {
+	t.Run("flushes after the window", func(t *testing.T) {
+		batches := make(chan []int, 10)
+		c := NewCoalescer(20*time.Millisecond, 0, func(batch []int) { batches <- batch })
+		defer c.Close()
+
+		c.Add(1, 2)
+		c.Add(3)
+		assert.Equal(t, 3, c.Pending())
+		assert.Equal(t, []int{1, 2, 3}, <-batches)
+		assert.Equal(t, 0, c.Pending())
+
+		c.Add(4)
+		assert.Equal(t, []int{4}, <-batches)
+	})
+
+	t.Run("flushes full batches immediately", func(t *testing.T) {
+		var mu sync.Mutex
+		batches := [][]int{}
+		c := NewCoalescer(time.Hour, 2, func(batch []int) {
+			mu.Lock()
+			defer mu.Unlock()
+			batches = append(batches, batch)
+		})
+
+		c.Add(1)
+		c.Add(2, 3, 4, 5)
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}}, batches)
+		assert.Equal(t, 1, c.Pending())
+
+		c.Close()
+		c.Close()
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, batches)
+		assert.Panics(t, func() { c.Add(6) })
+
+		defer func() {
+			err, _ := recover().(error)
+			assert.ErrorIs(t, err, ErrClosed)
+		}()
+		c.Add(6)
+	})
+
+	t.Run("flushes batches in order", func(t *testing.T) {
+		var (
+			mu      sync.Mutex
+			batches = [][]int{}
+			release = make(chan struct{})
+			first   = true
+		)
+		c := NewCoalescer(time.Hour, 2, func(batch []int) {
+			if first {
+				// hold the first flush while more batches are taken
+				first = false
+				<-release
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			batches = append(batches, batch)
+		})
+
+		var wg sync.WaitGroup
+		start := func(fn func()) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				fn()
+			}()
+			time.Sleep(20 * time.Millisecond)
+		}
+		start(func() { c.Add(1, 2) })
+		start(func() { c.Add(3, 4) })
+		start(func() {
+			c.Add(5)
+			c.Flush()
+		})
+		// adding items does not wait for the flush in progress
+		c.Add(6)
+		assert.Equal(t, 1, c.Pending())
+		close(release)
+		wg.Wait()
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, batches)
+		assert.Equal(t, 1, c.Pending())
+	})
+
+	t.Run("recovers from a panicking flush", func(t *testing.T) {
+		batches := [][]int{}
+		c := NewCoalescer(time.Hour, 1, func(batch []int) {
+			if batch[0] == 1 {
+				panic("flush failed")
+			}
+			batches = append(batches, batch)
+		})
+		assert.Panics(t, func() { c.Add(1, 2) })
+		c.Flush()
+		assert.Equal(t, [][]int{{2}}, batches)
+		c.Add(3)
+		assert.Equal(t, [][]int{{2}, {3}}, batches)
+	})
+
+	t.Run("flush splits pending items", func(t *testing.T) {
+		batches := [][]string{}
+		c := NewCoalescer(time.Hour, 3, func(batch []string) { batches = append(batches, batch) })
+		c.Flush()
+		assert.Empty(t, batches)
+
+		c.Add("a", "b")
+		c.Flush()
+		assert.Equal(t, [][]string{{"a", "b"}}, batches)
+	})
+
+	t.Run("panics on invalid arguments", func(t *testing.T) {
+		assert.Panics(t, func() { NewCoalescer(0, 1, func([]int) {}) })
+		assert.Panics(t, func() { NewCoalescer(time.Second, -1, func([]int) {}) })
+	})
+}
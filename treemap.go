@@ -0,0 +1,449 @@
+package gofunc
+
+// TreeMap is an ordered map backed by a left-leaning red-black tree.
+// Lookups, insertions and deletions run in O(log n), and keys are kept in ascending order,
+// which makes it suitable for data that changes constantly while still needing ordered
+// access, range queries and order statistics.
+// The zero value is not usable; create a TreeMap with NewTreeMap or NewTreeMapFunc.
+//
+// Example:
+//
+//	m := gofunc.NewTreeMap[int, string]()
+//	m.Put(3, "c")
+//	m.Put(1, "a")
+//	m.Put(2, "b")
+//	// m.Keys() is []int{1, 2, 3}
+type TreeMap[K any, V any] struct {
+	root *treeMapNode[K, V]
+	cmp  func(a, b K) int
+}
+
+type treeMapNode[K any, V any] struct {
+	key         K
+	value       V
+	left, right *treeMapNode[K, V]
+	red         bool
+	size        int // number of nodes in this subtree
+}
+
+// NewTreeMap creates an empty TreeMap ordered by the natural order of its keys.
+// Works with numeric types and strings.
+func NewTreeMap[K Number | ~string, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](compareOrdered[K])
+}
+
+// NewTreeMapFunc creates an empty TreeMap ordered by the given comparator.
+// The comparator must return a negative number when a < b, a positive number when a > b
+// and zero when both keys are equal.
+//
+// Example:
+//
+//	byTime := gofunc.NewTreeMapFunc[time.Time, string](func(a, b time.Time) int {
+//		return a.Compare(b)
+//	})
+func NewTreeMapFunc[K any, V any](cmp func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{cmp: cmp}
+}
+
+// Len returns the number of entries in the TreeMap.
+func (m *TreeMap[K, V]) Len() int {
+	return treeMapSize(m.root)
+}
+
+// Get returns the value stored under the given key.
+// Returns the zero value and false if the key is not present.
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	n := m.root
+	for n != nil {
+		c := m.cmp(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	var zeroV V
+	return zeroV, false
+}
+
+// Has reports whether the key is present in the TreeMap.
+func (m *TreeMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Put stores the value under the given key, replacing any previous value.
+// Returns true if the key was not present before.
+func (m *TreeMap[K, V]) Put(key K, value V) bool {
+	var added bool
+	m.root, added = m.put(m.root, key, value)
+	m.root.red = false
+	return added
+}
+
+func (m *TreeMap[K, V]) put(h *treeMapNode[K, V], key K, value V) (*treeMapNode[K, V], bool) {
+	if h == nil {
+		return &treeMapNode[K, V]{key: key, value: value, red: true, size: 1}, true
+	}
+
+	var added bool
+	c := m.cmp(key, h.key)
+	switch {
+	case c < 0:
+		h.left, added = m.put(h.left, key, value)
+	case c > 0:
+		h.right, added = m.put(h.right, key, value)
+	default:
+		h.value = value
+	}
+	return treeMapBalance(h), added
+}
+
+// Delete removes the given key from the TreeMap.
+// Returns true if the key was present.
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	if !m.Has(key) {
+		return false
+	}
+	if !treeMapIsRed(m.root.left) && !treeMapIsRed(m.root.right) {
+		m.root.red = true
+	}
+	m.root = m.delete(m.root, key)
+	if m.root != nil {
+		m.root.red = false
+	}
+	return true
+}
+
+// delete assumes the key is present in the subtree rooted at h.
+func (m *TreeMap[K, V]) delete(h *treeMapNode[K, V], key K) *treeMapNode[K, V] {
+	if m.cmp(key, h.key) < 0 {
+		if !treeMapIsRed(h.left) && !treeMapIsRed(h.left.left) {
+			h = treeMapMoveRedLeft(h)
+		}
+		h.left = m.delete(h.left, key)
+		return treeMapBalance(h)
+	}
+
+	if treeMapIsRed(h.left) {
+		h = treeMapRotateRight(h)
+	}
+	if m.cmp(key, h.key) == 0 && h.right == nil {
+		return nil
+	}
+	if !treeMapIsRed(h.right) && !treeMapIsRed(h.right.left) {
+		h = treeMapMoveRedRight(h)
+	}
+	if m.cmp(key, h.key) == 0 {
+		// replace the node with its successor, then remove the successor
+		successor := h.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		h.key, h.value = successor.key, successor.value
+		h.right = treeMapDeleteMin(h.right)
+	} else {
+		h.right = m.delete(h.right, key)
+	}
+	return treeMapBalance(h)
+}
+
+// Min returns the entry with the smallest key.
+// Returns ErrInputRequired if the TreeMap is empty, like the Min function.
+func (m *TreeMap[K, V]) Min() (K, V, error) {
+	if m.root == nil {
+		var (
+			zeroK K
+			zeroV V
+		)
+		return zeroK, zeroV, ErrInputRequired
+	}
+	n := m.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, nil
+}
+
+// Max returns the entry with the largest key.
+// Returns ErrInputRequired if the TreeMap is empty, like the Max function.
+func (m *TreeMap[K, V]) Max() (K, V, error) {
+	if m.root == nil {
+		var (
+			zeroK K
+			zeroV V
+		)
+		return zeroK, zeroV, ErrInputRequired
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, nil
+}
+
+// Floor returns the entry with the largest key less than or equal to the given key.
+// Returns zero values and false if there is no such entry.
+//
+// Example:
+//
+//	m := gofunc.NewTreeMap[int, string]()
+//	m.Put(10, "ten")
+//	m.Put(20, "twenty")
+//	key, value, ok := m.Floor(15)
+//	// key is 10, value is "ten", ok is true
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var best *treeMapNode[K, V]
+	n := m.root
+	for n != nil {
+		c := m.cmp(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best, n = n, n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	return treeMapEntry(best)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the given key.
+// Returns zero values and false if there is no such entry.
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *treeMapNode[K, V]
+	n := m.root
+	for n != nil {
+		c := m.cmp(key, n.key)
+		switch {
+		case c < 0:
+			best, n = n, n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	return treeMapEntry(best)
+}
+
+// Rank returns the number of keys strictly less than the given key.
+// The key does not need to be present in the TreeMap.
+func (m *TreeMap[K, V]) Rank(key K) int {
+	rank := 0
+	n := m.root
+	for n != nil {
+		c := m.cmp(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += 1 + treeMapSize(n.left)
+			n = n.right
+		default:
+			return rank + treeMapSize(n.left)
+		}
+	}
+	return rank
+}
+
+// Select returns the entry with the given rank, that is the i-th smallest key starting at 0.
+// Returns zero values and false if i is out of range.
+//
+// Example:
+//
+//	m := gofunc.NewTreeMap[string, int]()
+//	m.Put("b", 2)
+//	m.Put("a", 1)
+//	key, _, _ := m.Select(1)
+//	// key is "b"
+func (m *TreeMap[K, V]) Select(i int) (K, V, bool) {
+	n := m.root
+	for n != nil {
+		leftSize := treeMapSize(n.left)
+		switch {
+		case i < leftSize:
+			n = n.left
+		case i > leftSize:
+			i -= leftSize + 1
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	return treeMapEntry[K, V](nil)
+}
+
+// Range calls fn for every entry with lo <= key < hi, in ascending key order.
+// The iteration stops early if fn returns false.
+//
+// Example:
+//
+//	m.Range(10, 20, func(key int, value string) bool {
+//		fmt.Println(key, value)
+//		return true
+//	})
+func (m *TreeMap[K, V]) Range(lo, hi K, fn func(key K, value V) bool) {
+	m.rangeWalk(m.root, lo, hi, fn)
+}
+
+func (m *TreeMap[K, V]) rangeWalk(n *treeMapNode[K, V], lo, hi K, fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := m.cmp(lo, n.key) <= 0
+	belowHi := m.cmp(n.key, hi) < 0
+	if aboveLo && !m.rangeWalk(n.left, lo, hi, fn) {
+		return false
+	}
+	if aboveLo && belowHi && !fn(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return m.rangeWalk(n.right, lo, hi, fn)
+	}
+	return true
+}
+
+// Each calls fn for every entry in ascending key order.
+// The iteration stops early if fn returns false.
+func (m *TreeMap[K, V]) Each(fn func(key K, value V) bool) {
+	treeMapWalk(m.root, fn)
+}
+
+func treeMapWalk[K any, V any](n *treeMapNode[K, V], fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return treeMapWalk(n.left, fn) && fn(n.key, n.value) && treeMapWalk(n.right, fn)
+}
+
+// Keys returns all keys of the TreeMap in ascending order.
+func (m *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	m.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns all values of the TreeMap in ascending key order.
+func (m *TreeMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	m.Each(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+func treeMapEntry[K any, V any](n *treeMapNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			zeroK K
+			zeroV V
+		)
+		return zeroK, zeroV, false
+	}
+	return n.key, n.value, true
+}
+
+func treeMapIsRed[K any, V any](n *treeMapNode[K, V]) bool {
+	return n != nil && n.red
+}
+
+func treeMapSize[K any, V any](n *treeMapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func treeMapRotateLeft[K any, V any](h *treeMapNode[K, V]) *treeMapNode[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red, h.red = h.red, true
+	x.size = h.size
+	h.size = 1 + treeMapSize(h.left) + treeMapSize(h.right)
+	return x
+}
+
+func treeMapRotateRight[K any, V any](h *treeMapNode[K, V]) *treeMapNode[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red, h.red = h.red, true
+	x.size = h.size
+	h.size = 1 + treeMapSize(h.left) + treeMapSize(h.right)
+	return x
+}
+
+func treeMapFlipColors[K any, V any](h *treeMapNode[K, V]) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func treeMapMoveRedLeft[K any, V any](h *treeMapNode[K, V]) *treeMapNode[K, V] {
+	treeMapFlipColors(h)
+	if treeMapIsRed(h.right.left) {
+		h.right = treeMapRotateRight(h.right)
+		h = treeMapRotateLeft(h)
+		treeMapFlipColors(h)
+	}
+	return h
+}
+
+func treeMapMoveRedRight[K any, V any](h *treeMapNode[K, V]) *treeMapNode[K, V] {
+	treeMapFlipColors(h)
+	if treeMapIsRed(h.left.left) {
+		h = treeMapRotateRight(h)
+		treeMapFlipColors(h)
+	}
+	return h
+}
+
+func treeMapDeleteMin[K any, V any](h *treeMapNode[K, V]) *treeMapNode[K, V] {
+	if h.left == nil {
+		return nil
+	}
+	if !treeMapIsRed(h.left) && !treeMapIsRed(h.left.left) {
+		h = treeMapMoveRedLeft(h)
+	}
+	h.left = treeMapDeleteMin(h.left)
+	return treeMapBalance(h)
+}
+
+// treeMapBalance restores the left-leaning red-black invariants on the way up
+// after an insertion or deletion, and refreshes the subtree size.
+func treeMapBalance[K any, V any](h *treeMapNode[K, V]) *treeMapNode[K, V] {
+	if treeMapIsRed(h.right) && !treeMapIsRed(h.left) {
+		h = treeMapRotateLeft(h)
+	}
+	if treeMapIsRed(h.left) && treeMapIsRed(h.left.left) {
+		h = treeMapRotateRight(h)
+	}
+	if treeMapIsRed(h.left) && treeMapIsRed(h.right) {
+		treeMapFlipColors(h)
+	}
+	h.size = 1 + treeMapSize(h.left) + treeMapSize(h.right)
+	return h
+}
+
+// compareOrdered compares two values using the natural order of numbers and strings.
+func compareOrdered[T Number | ~string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,227 @@
+package gofunc
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// checkTreeMapInvariants verifies the left-leaning red-black properties and the
+// subtree sizes, returning the black height of the tree.
+func checkTreeMapInvariants[K any, V any](t *testing.T, n *treeMapNode[K, V], cmp func(a, b K) int) int {
+	if n == nil {
+		return 0
+	}
+	assert.False(t, treeMapIsRed(n.right), "right-leaning red link")
+	assert.False(t, n.red && treeMapIsRed(n.left), "two red links in a row")
+	assert.Equal(t, 1+treeMapSize(n.left)+treeMapSize(n.right), n.size)
+	if n.left != nil {
+		assert.Less(t, cmp(n.left.key, n.key), 0)
+	}
+	if n.right != nil {
+		assert.Greater(t, cmp(n.right.key, n.key), 0)
+	}
+
+	left := checkTreeMapInvariants(t, n.left, cmp)
+	right := checkTreeMapInvariants(t, n.right, cmp)
+	assert.Equal(t, left, right, "unbalanced black height")
+	if n.red {
+		return left
+	}
+	return left + 1
+}
+
+func Test_TreeMap_PutGetDelete(t *testing.T) {
+	m := NewTreeMap[string, int]()
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Delete("a"))
+
+	assert.True(t, m.Put("b", 2))
+	assert.True(t, m.Put("a", 1))
+	assert.True(t, m.Put("c", 3))
+	assert.False(t, m.Put("a", 10))
+	assert.Equal(t, 3, m.Len())
+
+	v, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	_, ok = m.Get("d")
+	assert.False(t, ok)
+	assert.True(t, m.Has("c"))
+
+	assert.True(t, m.Delete("b"))
+	assert.False(t, m.Delete("b"))
+	assert.Equal(t, []string{"a", "c"}, m.Keys())
+	assert.Equal(t, []int{10, 3}, m.Values())
+}
+
+func Test_TreeMap_MinMax(t *testing.T) {
+	m := NewTreeMap[float64, string]()
+	_, _, e := m.Min()
+	assert.ErrorIs(t, e, ErrInputRequired)
+	_, _, e = m.Max()
+	assert.ErrorIs(t, e, ErrInputRequired)
+
+	m.Put(1.5, "x")
+	m.Put(-2, "y")
+	m.Put(10, "z")
+	k, v, e := m.Min()
+	assert.NoError(t, e)
+	assert.Equal(t, -2.0, k)
+	assert.Equal(t, "y", v)
+	k, v, e = m.Max()
+	assert.NoError(t, e)
+	assert.Equal(t, 10.0, k)
+	assert.Equal(t, "z", v)
+}
+
+func Test_TreeMap_FloorCeiling(t *testing.T) {
+	m := NewTreeMap[int, string]()
+	_, _, ok := m.Floor(1)
+	assert.False(t, ok)
+
+	for _, k := range []int{10, 20, 30} {
+		m.Put(k, strings.Repeat("x", k/10))
+	}
+
+	k, v, ok := m.Floor(25)
+	assert.True(t, ok)
+	assert.Equal(t, 20, k)
+	assert.Equal(t, "xx", v)
+	k, _, ok = m.Floor(30)
+	assert.True(t, ok)
+	assert.Equal(t, 30, k)
+	_, _, ok = m.Floor(9)
+	assert.False(t, ok)
+
+	k, _, ok = m.Ceiling(11)
+	assert.True(t, ok)
+	assert.Equal(t, 20, k)
+	k, _, ok = m.Ceiling(10)
+	assert.True(t, ok)
+	assert.Equal(t, 10, k)
+	_, _, ok = m.Ceiling(31)
+	assert.False(t, ok)
+}
+
+func Test_TreeMap_RankSelect(t *testing.T) {
+	m := NewTreeMap[int, int]()
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		m.Put(k, k*2)
+	}
+
+	assert.Equal(t, 0, m.Rank(5))
+	assert.Equal(t, 0, m.Rank(10))
+	assert.Equal(t, 2, m.Rank(25))
+	assert.Equal(t, 2, m.Rank(30))
+	assert.Equal(t, 5, m.Rank(100))
+
+	for i, expected := range []int{10, 20, 30, 40, 50} {
+		k, v, ok := m.Select(i)
+		assert.True(t, ok)
+		assert.Equal(t, expected, k)
+		assert.Equal(t, expected*2, v)
+		assert.Equal(t, i, m.Rank(k))
+	}
+	_, _, ok := m.Select(5)
+	assert.False(t, ok)
+	_, _, ok = m.Select(-1)
+	assert.False(t, ok)
+}
+
+func Test_TreeMap_Range(t *testing.T) {
+	m := NewTreeMap[int, int]()
+	for i := 0; i < 100; i += 10 {
+		m.Put(i, i)
+	}
+
+	collect := func(lo, hi int) []int {
+		keys := []int{}
+		m.Range(lo, hi, func(key int, _ int) bool {
+			keys = append(keys, key)
+			return true
+		})
+		return keys
+	}
+	assert.Equal(t, []int{20, 30, 40}, collect(20, 50))
+	assert.Equal(t, []int{20, 30, 40, 50}, collect(15, 51))
+	assert.Equal(t, []int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90}, collect(-100, 100))
+	assert.Equal(t, []int{}, collect(21, 29))
+	assert.Equal(t, []int{}, collect(50, 20))
+
+	// Early stop
+	keys := []int{}
+	m.Range(0, 100, func(key int, _ int) bool {
+		keys = append(keys, key)
+		return len(keys) < 3
+	})
+	assert.Equal(t, []int{0, 10, 20}, keys)
+
+	keys = []int{}
+	m.Each(func(key int, _ int) bool {
+		keys = append(keys, key)
+		return key < 40
+	})
+	assert.Equal(t, []int{0, 10, 20, 30, 40}, keys)
+}
+
+func Test_TreeMap_Comparator(t *testing.T) {
+	type Version struct{ Major, Minor int }
+	m := NewTreeMapFunc[Version, string](func(a, b Version) int {
+		if a.Major != b.Major {
+			return a.Major - b.Major
+		}
+		return a.Minor - b.Minor
+	})
+	m.Put(Version{1, 10}, "1.10")
+	m.Put(Version{1, 2}, "1.2")
+	m.Put(Version{0, 9}, "0.9")
+
+	assert.Equal(t, []string{"0.9", "1.2", "1.10"}, m.Values())
+	k, _, ok := m.Floor(Version{1, 5})
+	assert.True(t, ok)
+	assert.Equal(t, Version{1, 2}, k)
+}
+
+func Test_TreeMap_RandomizedAgainstMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewTreeMap[int, int]()
+	expected := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		k := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, existed := expected[k]
+			delete(expected, k)
+			assert.Equal(t, existed, m.Delete(k))
+		} else {
+			_, existed := expected[k]
+			expected[k] = i
+			assert.Equal(t, !existed, m.Put(k, i))
+		}
+		if i%500 == 0 {
+			checkTreeMapInvariants(t, m.root, m.cmp)
+		}
+	}
+	checkTreeMapInvariants(t, m.root, m.cmp)
+
+	keys := MapKeys(expected)
+	sort.Ints(keys)
+	assert.Equal(t, keys, m.Keys())
+	assert.Equal(t, len(expected), m.Len())
+	for i, k := range keys {
+		v, ok := m.Get(k)
+		assert.True(t, ok)
+		assert.Equal(t, expected[k], v)
+		assert.Equal(t, i, m.Rank(k))
+	}
+
+	for _, k := range keys {
+		assert.True(t, m.Delete(k))
+	}
+	assert.Equal(t, 0, m.Len())
+	assert.Nil(t, m.root)
+}
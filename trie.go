@@ -0,0 +1,461 @@
+package gofunc
+
+import (
+	"sort"
+	"strings"
+)
+
+// Trie is a prefix tree mapping string-like keys to values.
+// Keys are split into bytes, so lookups cost O(len(key)) regardless of the number of keys,
+// and keys sharing a prefix can be enumerated or counted efficiently.
+// Walks visit keys in ascending byte-wise order, the same order Sort uses for strings.
+// The zero value is not usable; create a Trie with NewTrie.
+//
+// Example:
+//
+//	type Username string
+//	t := gofunc.NewTrie[Username, int]()
+//	t.Insert("alice", 1)
+//	t.Insert("alex", 2)
+//	// t.CountPrefix("al") is 2
+type Trie[K ~string, V any] struct {
+	root *trieNode[V]
+}
+
+type trieNode[V any] struct {
+	label    byte
+	children []*trieNode[V] // sorted by label
+	value    V
+	hasValue bool
+	size     int // number of values stored in this subtree
+}
+
+// NewTrie creates an empty Trie.
+func NewTrie[K ~string, V any]() *Trie[K, V] {
+	return &Trie[K, V]{root: &trieNode[V]{}}
+}
+
+// Len returns the number of keys stored in the Trie.
+func (t *Trie[K, V]) Len() int {
+	return t.root.size
+}
+
+// Insert stores the value under the given key, replacing any previous value.
+// Returns true if the key was not present before.
+//
+// Example:
+//
+//	t := gofunc.NewTrie[string, int]()
+//	added := t.Insert("apple", 1)
+//	// added is true
+func (t *Trie[K, V]) Insert(key K, value V) bool {
+	return t.root.insert(string(key), value)
+}
+
+func (n *trieNode[V]) insert(key string, value V) bool {
+	if key == "" {
+		added := !n.hasValue
+		n.value, n.hasValue = value, true
+		if added {
+			n.size++
+		}
+		return added
+	}
+
+	i, found := n.find(key[0])
+	if !found {
+		child := &trieNode[V]{label: key[0]}
+		n.children = append(n.children, nil)
+		copy(n.children[i+1:], n.children[i:])
+		n.children[i] = child
+	}
+	added := n.children[i].insert(key[1:], value)
+	if added {
+		n.size++
+	}
+	return added
+}
+
+// Get returns the value stored under the given key.
+// Returns the zero value and false if the key is not present.
+func (t *Trie[K, V]) Get(key K) (V, bool) {
+	n := t.root.lookup(string(key))
+	if n == nil || !n.hasValue {
+		var zeroV V
+		return zeroV, false
+	}
+	return n.value, true
+}
+
+// Delete removes the given key from the Trie.
+// Returns true if the key was present.
+func (t *Trie[K, V]) Delete(key K) bool {
+	return t.root.delete(string(key))
+}
+
+func (n *trieNode[V]) delete(key string) bool {
+	if key == "" {
+		if !n.hasValue {
+			return false
+		}
+		var zeroV V
+		n.value, n.hasValue = zeroV, false
+		n.size--
+		return true
+	}
+
+	i, found := n.find(key[0])
+	if !found {
+		return false
+	}
+	child := n.children[i]
+	if !child.delete(key[1:]) {
+		return false
+	}
+	n.size--
+	if child.size == 0 {
+		// prune the empty branch so it doesn't keep memory alive
+		copy(n.children[i:], n.children[i+1:])
+		n.children[len(n.children)-1] = nil
+		n.children = n.children[:len(n.children)-1]
+	}
+	return true
+}
+
+// LongestPrefixMatch finds the longest key in the Trie that is a prefix of s.
+// Returns the matching key, its value and true, or zero values and false if no key matches.
+// This is useful for routing tables, where the most specific route should win.
+//
+// Example:
+//
+//	t := gofunc.NewTrie[string, string]()
+//	t.Insert("/api", "api")
+//	t.Insert("/api/users", "users")
+//	key, value, ok := t.LongestPrefixMatch("/api/users/42")
+//	// key is "/api/users", value is "users", ok is true
+func (t *Trie[K, V]) LongestPrefixMatch(s K) (K, V, bool) {
+	var (
+		str     = string(s)
+		n       = t.root
+		matched = -1
+		value   V
+	)
+	for depth := 0; ; depth++ {
+		if n.hasValue {
+			matched, value = depth, n.value
+		}
+		if depth == len(str) {
+			break
+		}
+		i, found := n.find(str[depth])
+		if !found {
+			break
+		}
+		n = n.children[i]
+	}
+
+	if matched < 0 {
+		var zeroK K
+		return zeroK, value, false
+	}
+	return K(str[:matched]), value, true
+}
+
+// WalkPrefix calls fn for every key starting with prefix, in ascending order.
+// The walk stops early if fn returns false.
+//
+// Example:
+//
+//	t.WalkPrefix("al", func(key string, value int) bool {
+//		fmt.Println(key, value)
+//		return true
+//	})
+func (t *Trie[K, V]) WalkPrefix(prefix K, fn func(key K, value V) bool) {
+	n := t.root.lookup(string(prefix))
+	if n == nil {
+		return
+	}
+	buf := []byte(prefix)
+	n.walk(&buf, func(key []byte, value V) bool { return fn(K(key), value) })
+}
+
+func (n *trieNode[V]) walk(buf *[]byte, fn func(key []byte, value V) bool) bool {
+	if n.hasValue && !fn(*buf, n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		*buf = append(*buf, child.label)
+		ok := child.walk(buf, fn)
+		*buf = (*buf)[:len(*buf)-1]
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// CountPrefix returns the number of keys starting with prefix.
+// It runs in O(len(prefix)) since each node tracks the size of its subtree.
+func (t *Trie[K, V]) CountPrefix(prefix K) int {
+	n := t.root.lookup(string(prefix))
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *trieNode[V]) lookup(key string) *trieNode[V] {
+	for i := 0; i < len(key); i++ {
+		j, found := n.find(key[i])
+		if !found {
+			return nil
+		}
+		n = n.children[j]
+	}
+	return n
+}
+
+func (n *trieNode[V]) find(label byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].label >= label })
+	return i, i < len(n.children) && n.children[i].label == label
+}
+
+// RadixTrie is a radix-compressed prefix tree with the same API as Trie.
+// Chains of nodes with a single child are merged into one edge labelled with a substring,
+// which uses much less memory than Trie when keys are long or share few prefixes.
+// The zero value is not usable; create a RadixTrie with NewRadixTrie.
+//
+// Example:
+//
+//	t := gofunc.NewRadixTrie[string, int]()
+//	t.Insert("/users/list", 1)
+//	t.Insert("/users/show", 2)
+//	// the tree holds a single "/users/" edge with two children
+type RadixTrie[K ~string, V any] struct {
+	root *radixNode[V]
+}
+
+type radixNode[V any] struct {
+	prefix   string
+	children []*radixNode[V] // sorted by the first byte of their prefix
+	value    V
+	hasValue bool
+	size     int // number of values stored in this subtree
+}
+
+// NewRadixTrie creates an empty RadixTrie.
+func NewRadixTrie[K ~string, V any]() *RadixTrie[K, V] {
+	return &RadixTrie[K, V]{root: &radixNode[V]{}}
+}
+
+// Len returns the number of keys stored in the RadixTrie.
+func (t *RadixTrie[K, V]) Len() int {
+	return t.root.size
+}
+
+// Insert stores the value under the given key, replacing any previous value.
+// Returns true if the key was not present before.
+func (t *RadixTrie[K, V]) Insert(key K, value V) bool {
+	return t.root.insert(string(key), value)
+}
+
+func (n *radixNode[V]) insert(key string, value V) bool {
+	if key == "" {
+		added := !n.hasValue
+		n.value, n.hasValue = value, true
+		if added {
+			n.size++
+		}
+		return added
+	}
+
+	i, found := n.find(key[0])
+	if !found {
+		leaf := &radixNode[V]{prefix: key, value: value, hasValue: true, size: 1}
+		n.children = append(n.children, nil)
+		copy(n.children[i+1:], n.children[i:])
+		n.children[i] = leaf
+		n.size++
+		return true
+	}
+
+	child := n.children[i]
+	l := commonPrefixLen(child.prefix, key)
+	if l < len(child.prefix) {
+		// split the edge so that the shared part becomes its own node
+		split := &radixNode[V]{prefix: child.prefix[:l], size: child.size}
+		child.prefix = child.prefix[l:]
+		split.children = []*radixNode[V]{child}
+		n.children[i] = split
+		child = split
+	}
+	added := child.insert(key[l:], value)
+	if added {
+		n.size++
+	}
+	return added
+}
+
+// Get returns the value stored under the given key.
+// Returns the zero value and false if the key is not present.
+func (t *RadixTrie[K, V]) Get(key K) (V, bool) {
+	n, rest := t.root.lookup(string(key))
+	if n == nil || rest != "" || !n.hasValue {
+		var zeroV V
+		return zeroV, false
+	}
+	return n.value, true
+}
+
+// Delete removes the given key from the RadixTrie.
+// Returns true if the key was present.
+func (t *RadixTrie[K, V]) Delete(key K) bool {
+	return t.root.delete(string(key))
+}
+
+func (n *radixNode[V]) delete(key string) bool {
+	if key == "" {
+		if !n.hasValue {
+			return false
+		}
+		var zeroV V
+		n.value, n.hasValue = zeroV, false
+		n.size--
+		return true
+	}
+
+	i, found := n.find(key[0])
+	if !found || !strings.HasPrefix(key, n.children[i].prefix) {
+		return false
+	}
+	child := n.children[i]
+	if !child.delete(key[len(child.prefix):]) {
+		return false
+	}
+	n.size--
+
+	switch {
+	case child.size == 0:
+		copy(n.children[i:], n.children[i+1:])
+		n.children[len(n.children)-1] = nil
+		n.children = n.children[:len(n.children)-1]
+	case !child.hasValue && len(child.children) == 1:
+		// merge the child with its only descendant to keep the tree compressed
+		grandchild := child.children[0]
+		grandchild.prefix = child.prefix + grandchild.prefix
+		n.children[i] = grandchild
+	}
+	return true
+}
+
+// LongestPrefixMatch finds the longest key in the RadixTrie that is a prefix of s.
+// Returns the matching key, its value and true, or zero values and false if no key matches.
+func (t *RadixTrie[K, V]) LongestPrefixMatch(s K) (K, V, bool) {
+	var (
+		str      = string(s)
+		rest     = str
+		n        = t.root
+		matched  = -1
+		value    V
+		consumed int
+	)
+	for {
+		if n.hasValue {
+			matched, value = consumed, n.value
+		}
+		if rest == "" {
+			break
+		}
+		i, found := n.find(rest[0])
+		if !found || !strings.HasPrefix(rest, n.children[i].prefix) {
+			break
+		}
+		n = n.children[i]
+		rest = rest[len(n.prefix):]
+		consumed += len(n.prefix)
+	}
+
+	if matched < 0 {
+		var zeroK K
+		return zeroK, value, false
+	}
+	return K(str[:matched]), value, true
+}
+
+// WalkPrefix calls fn for every key starting with prefix, in ascending order.
+// The walk stops early if fn returns false.
+func (t *RadixTrie[K, V]) WalkPrefix(prefix K, fn func(key K, value V) bool) {
+	n, rest := t.root.lookup(string(prefix))
+	if n == nil {
+		return
+	}
+	// the prefix may end in the middle of the edge leading to n
+	buf := []byte(prefix)
+	buf = append(buf, rest...)
+	n.walk(&buf, func(key []byte, value V) bool { return fn(K(key), value) })
+}
+
+func (n *radixNode[V]) walk(buf *[]byte, fn func(key []byte, value V) bool) bool {
+	if n.hasValue && !fn(*buf, n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		*buf = append(*buf, child.prefix...)
+		ok := child.walk(buf, fn)
+		*buf = (*buf)[:len(*buf)-len(child.prefix)]
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// CountPrefix returns the number of keys starting with prefix.
+func (t *RadixTrie[K, V]) CountPrefix(prefix K) int {
+	n, _ := t.root.lookup(string(prefix))
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// lookup descends towards key and returns the first node whose path covers the whole key,
+// along with the part of that node's edge lying beyond the key.
+// Returns nil if no key in the tree starts with key.
+func (n *radixNode[V]) lookup(key string) (*radixNode[V], string) {
+	for key != "" {
+		i, found := n.find(key[0])
+		if !found {
+			return nil, ""
+		}
+		child := n.children[i]
+		switch {
+		case strings.HasPrefix(key, child.prefix):
+			key = key[len(child.prefix):]
+		case strings.HasPrefix(child.prefix, key):
+			return child, child.prefix[len(key):]
+		default:
+			return nil, ""
+		}
+		n = child
+	}
+	return n, ""
+}
+
+func (n *radixNode[V]) find(label byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].prefix[0] >= label })
+	return i, i < len(n.children) && n.children[i].prefix[0] == label
+}
+
+func commonPrefixLen(a, b string) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
@@ -0,0 +1,226 @@
+package gofunc
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPrefixTree[K ~string, V any] interface {
+	Len() int
+	Insert(key K, value V) bool
+	Get(key K) (V, bool)
+	Delete(key K) bool
+	LongestPrefixMatch(s K) (K, V, bool)
+	WalkPrefix(prefix K, fn func(key K, value V) bool)
+	CountPrefix(prefix K) int
+}
+
+type testUsername string
+
+func testPrefixTrees() map[string]func() testPrefixTree[testUsername, int] {
+	return map[string]func() testPrefixTree[testUsername, int]{
+		"Trie":      func() testPrefixTree[testUsername, int] { return NewTrie[testUsername, int]() },
+		"RadixTrie": func() testPrefixTree[testUsername, int] { return NewRadixTrie[testUsername, int]() },
+	}
+}
+
+func collectPrefix(t testPrefixTree[testUsername, int], prefix testUsername) []testUsername {
+	keys := []testUsername{}
+	t.WalkPrefix(prefix, func(key testUsername, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func Test_Trie_InsertGetDelete(t *testing.T) {
+	for name, newTree := range testPrefixTrees() {
+		t.Run(name, func(t *testing.T) {
+			tree := newTree()
+			assert.True(t, tree.Insert("alice", 1))
+			assert.True(t, tree.Insert("alex", 2))
+			assert.True(t, tree.Insert("al", 3))
+			assert.True(t, tree.Insert("", 4))
+			assert.False(t, tree.Insert("alex", 5))
+			assert.Equal(t, 4, tree.Len())
+
+			v, ok := tree.Get("alex")
+			assert.True(t, ok)
+			assert.Equal(t, 5, v)
+			v, ok = tree.Get("")
+			assert.True(t, ok)
+			assert.Equal(t, 4, v)
+			_, ok = tree.Get("a")
+			assert.False(t, ok)
+			_, ok = tree.Get("alicex")
+			assert.False(t, ok)
+			_, ok = tree.Get("bob")
+			assert.False(t, ok)
+
+			assert.False(t, tree.Delete("a"))
+			assert.False(t, tree.Delete("bob"))
+			assert.True(t, tree.Delete("al"))
+			assert.False(t, tree.Delete("al"))
+			assert.Equal(t, 3, tree.Len())
+			_, ok = tree.Get("al")
+			assert.False(t, ok)
+
+			v, ok = tree.Get("alice")
+			assert.True(t, ok)
+			assert.Equal(t, 1, v)
+
+			assert.True(t, tree.Delete("alice"))
+			assert.True(t, tree.Delete("alex"))
+			assert.True(t, tree.Delete(""))
+			assert.Equal(t, 0, tree.Len())
+			assert.Equal(t, []testUsername{}, collectPrefix(tree, ""))
+		})
+	}
+}
+
+func Test_Trie_LongestPrefixMatch(t *testing.T) {
+	for name, newTree := range testPrefixTrees() {
+		t.Run(name, func(t *testing.T) {
+			tree := newTree()
+			_, _, ok := tree.LongestPrefixMatch("/api")
+			assert.False(t, ok)
+
+			tree.Insert("/api", 1)
+			tree.Insert("/api/users", 2)
+			tree.Insert("/api/users/admin", 3)
+
+			key, v, ok := tree.LongestPrefixMatch("/api/users/42")
+			assert.True(t, ok)
+			assert.Equal(t, testUsername("/api/users"), key)
+			assert.Equal(t, 2, v)
+
+			key, v, ok = tree.LongestPrefixMatch("/api/us")
+			assert.True(t, ok)
+			assert.Equal(t, testUsername("/api"), key)
+			assert.Equal(t, 1, v)
+
+			key, _, ok = tree.LongestPrefixMatch("/api/users/admin")
+			assert.True(t, ok)
+			assert.Equal(t, testUsername("/api/users/admin"), key)
+
+			_, _, ok = tree.LongestPrefixMatch("/ap")
+			assert.False(t, ok)
+
+			tree.Insert("", 0)
+			key, v, ok = tree.LongestPrefixMatch("/other")
+			assert.True(t, ok)
+			assert.Equal(t, testUsername(""), key)
+			assert.Equal(t, 0, v)
+		})
+	}
+}
+
+func Test_Trie_WalkPrefix(t *testing.T) {
+	for name, newTree := range testPrefixTrees() {
+		t.Run(name, func(t *testing.T) {
+			tree := newTree()
+			for i, k := range []testUsername{"bob", "alice", "alex", "al", "alfred", "b", "carol"} {
+				tree.Insert(k, i)
+			}
+
+			assert.Equal(t, []testUsername{"al", "alex", "alfred", "alice", "b", "bob", "carol"}, collectPrefix(tree, ""))
+			assert.Equal(t, []testUsername{"al", "alex", "alfred", "alice"}, collectPrefix(tree, "al"))
+			assert.Equal(t, []testUsername{"alex"}, collectPrefix(tree, "ale"))
+			assert.Equal(t, []testUsername{"alice"}, collectPrefix(tree, "alice"))
+			assert.Equal(t, []testUsername{}, collectPrefix(tree, "alicia"))
+			assert.Equal(t, []testUsername{}, collectPrefix(tree, "z"))
+
+			// Early stop
+			keys := []testUsername{}
+			tree.WalkPrefix("", func(key testUsername, _ int) bool {
+				keys = append(keys, key)
+				return len(keys) < 2
+			})
+			assert.Equal(t, []testUsername{"al", "alex"}, keys)
+		})
+	}
+}
+
+func Test_Trie_CountPrefix(t *testing.T) {
+	for name, newTree := range testPrefixTrees() {
+		t.Run(name, func(t *testing.T) {
+			tree := newTree()
+			for i, k := range []testUsername{"bob", "alice", "alex", "al", "alfred"} {
+				tree.Insert(k, i)
+			}
+
+			assert.Equal(t, 5, tree.CountPrefix(""))
+			assert.Equal(t, 4, tree.CountPrefix("a"))
+			assert.Equal(t, 4, tree.CountPrefix("al"))
+			assert.Equal(t, 1, tree.CountPrefix("alf"))
+			assert.Equal(t, 0, tree.CountPrefix("alfredo"))
+			assert.Equal(t, 0, tree.CountPrefix("c"))
+
+			tree.Delete("alfred")
+			assert.Equal(t, 3, tree.CountPrefix("al"))
+			assert.Equal(t, 0, tree.CountPrefix("alf"))
+		})
+	}
+}
+
+func Test_Trie_RandomizedAgainstMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	alphabet := "abc"
+	randomKey := func() testUsername {
+		b := make([]byte, r.Intn(6))
+		for i := range b {
+			b[i] = alphabet[r.Intn(len(alphabet))]
+		}
+		return testUsername(b)
+	}
+
+	for name, newTree := range testPrefixTrees() {
+		t.Run(name, func(t *testing.T) {
+			tree := newTree()
+			expected := map[testUsername]int{}
+			for i := 0; i < 2000; i++ {
+				k := randomKey()
+				if r.Intn(3) == 0 {
+					_, existed := expected[k]
+					delete(expected, k)
+					assert.Equal(t, existed, tree.Delete(k))
+				} else {
+					_, existed := expected[k]
+					expected[k] = i
+					assert.Equal(t, !existed, tree.Insert(k, i))
+				}
+			}
+
+			keys := MapKeys(expected)
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+			assert.Equal(t, keys, collectPrefix(tree, ""))
+			assert.Equal(t, len(expected), tree.Len())
+			for k, v := range expected {
+				got, ok := tree.Get(k)
+				assert.True(t, ok)
+				assert.Equal(t, v, got)
+			}
+		})
+	}
+}
+
+func Test_RadixTrie_Compression(t *testing.T) {
+	tree := NewRadixTrie[string, int]()
+	tree.Insert("/users/list", 1)
+	tree.Insert("/users/show", 2)
+	tree.Insert("/users/search", 3)
+
+	assert.Len(t, tree.root.children, 1)
+	assert.Equal(t, "/users/", tree.root.children[0].prefix)
+	assert.Len(t, tree.root.children[0].children, 2)
+
+	// Removing keys merges single-child chains back into one edge
+	tree.Delete("/users/list")
+	tree.Delete("/users/search")
+	assert.Len(t, tree.root.children, 1)
+	assert.Equal(t, "/users/show", tree.root.children[0].prefix)
+	assert.Empty(t, tree.root.children[0].children)
+}
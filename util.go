@@ -15,3 +15,22 @@ func Must[T any](v T, e error) T {
 
 	return v
 }
+
+// Try calls fn and converts a panic into an error, making it the inverse of Must.
+// The error is a *PanicError holding the recovered value; if that value is an error,
+// such as the ones raised by Must, Abs or ChunkSlice, it can be matched with errors.Is and errors.As.
+//
+// Example:
+//
+//	value, err := gofunc.Try(func() int { return gofunc.Must(strconv.Atoi("x")) })
+//	// value is 0, err wraps the strconv error
+func Try[T any](fn func() T) (v T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zeroT T
+			v, err = zeroT, &PanicError{Value: r}
+		}
+	}()
+
+	return fn(), nil
+}
@@ -19,3 +19,22 @@ func Test_Util_Must(t *testing.T) {
 	}()
 	assert.Equal(t, 0, Must(func() (int, error) { return 0, errors.New("error") }()))
 }
+
+func Test_Util_Try(t *testing.T) {
+	v, e := Try(func() int { return 42 })
+	assert.NoError(t, e)
+	assert.Equal(t, 42, v)
+
+	errBoom := errors.New("boom")
+	v, e = Try(func() int { return Must(0, errBoom) })
+	assert.ErrorIs(t, e, errBoom)
+	assert.Equal(t, 0, v)
+
+	_, e = Try(func() string { panic("not an error") })
+	var panicErr *PanicError
+	assert.ErrorAs(t, e, &panicErr)
+	assert.Equal(t, "not an error", panicErr.Value)
+
+	_, e = Try(func() [][]int { return ChunkSlice([]int{1}, 0) })
+	assert.ErrorIs(t, e, ErrInvalidArgument)
+}